@@ -0,0 +1,8 @@
+@echo off
+title Zombie Server
+echo [ Zombie Server ] Migrating Profiles
+
+cd ./../
+server.exe -migrate_profiles -return
+
+pause
\ No newline at end of file
@@ -0,0 +1,8 @@
+@echo off
+title Zombie Server
+echo [ Zombie Server ] Reconciling V-Bucks
+
+cd ./../
+server.exe -reconcile_vbucks -return
+
+pause
\ No newline at end of file
@@ -0,0 +1,8 @@
+@echo off
+title Zombie Server
+echo [ Zombie Server ] Rolling Over Season
+
+cd ./../
+server.exe -rollover_season -return
+
+pause
\ No newline at end of file
@@ -32,4 +32,13 @@ func AutoMigrate() {
 
 	Postgres.AutoMigrate(&models.UserProfile{})
 	Postgres.AutoMigrate(&models.UserLoadout{})
+	Postgres.AutoMigrate(&models.SeasonHistory{})
+	Postgres.AutoMigrate(&models.VBucksTransaction{})
+	Postgres.AutoMigrate(&models.CreatorCode{})
+	Postgres.AutoMigrate(&models.CreatorPurchase{})
+	Postgres.AutoMigrate(&models.ShopItemAppearance{})
+	Postgres.AutoMigrate(&models.ShopHistory{})
+	Postgres.AutoMigrate(&models.ShopPlan{})
+	Postgres.AutoMigrate(&models.ShopSale{})
+	Postgres.AutoMigrate(&models.WishlistItem{})
 }
\ No newline at end of file
@@ -0,0 +1,236 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	AllBattlePasses = make(map[int]models.BattlePass)
+	battlePassMutex sync.RWMutex
+	BattleBundleTiers int = 25
+)
+
+func GetBattlePass(season int) (models.BattlePass, error) {
+	battlePassMutex.RLock()
+	pass, ok := AllBattlePasses[season]
+	battlePassMutex.RUnlock()
+	if ok {
+		return pass, nil
+	}
+
+	file, err := os.Open("data/pass/" + strconv.Itoa(season) + ".json")
+	if err != nil {
+		return models.BattlePass{}, err
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return models.BattlePass{}, err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	err = json.Unmarshal([]byte(str), &pass)
+	if err != nil {
+		return models.BattlePass{}, err
+	}
+
+	battlePassMutex.Lock()
+	AllBattlePasses[season] = pass
+	battlePassMutex.Unlock()
+
+	return pass, nil
+}
+
+func CapBattlePassLevel(pass models.BattlePass, level int) int {
+	if level > len(pass.PaidRewards) {
+		return len(pass.PaidRewards)
+	}
+
+	if level < 1 {
+		return 1
+	}
+
+	return level
+}
+
+func appendTierRewards(rewards []models.BattlePassReward, tierRewards map[string]int, tier int, paid bool) []models.BattlePassReward {
+	templateIds := []string{}
+	for templateId := range tierRewards {
+		templateIds = append(templateIds, templateId)
+	}
+	sort.Strings(templateIds)
+
+	for _, templateId := range templateIds {
+		rewards = append(rewards, models.BattlePassReward{
+			TemplateId: templateId,
+			Quantity: tierRewards[templateId],
+			Tier: tier,
+			Paid: paid,
+		})
+	}
+
+	return rewards
+}
+
+// tiers are 1 based and inclusive, the first tier is unlocked as soon as the season starts
+func GetBattlePassRewards(pass models.BattlePass, fromTier int, toTier int, includeFree bool, includePaid bool) []models.BattlePassReward {
+	rewards := []models.BattlePassReward{}
+
+	for tier := fromTier; tier <= toTier; tier++ {
+		if includeFree && tier >= 1 && tier <= len(pass.FreeRewards) {
+			rewards = appendTierRewards(rewards, pass.FreeRewards[tier-1], tier, false)
+		}
+
+		if includePaid && tier >= 1 && tier <= len(pass.PaidRewards) {
+			rewards = appendTierRewards(rewards, pass.PaidRewards[tier-1], tier, true)
+		}
+	}
+
+	return rewards
+}
+
+func GrantBattlePassRewards(athenaProfile *models.Profile, commonCore *models.Profile, rewards []models.BattlePassReward, accountId string) models.BattlePassGrantResult {
+	result := models.BattlePassGrantResult{
+		LootItems: []models.LootResultItem{},
+		AthenaChanges: []models.ProfileChange{},
+		CommonCoreChanges: []models.ProfileChange{},
+	}
+	modifiedStats := []string{}
+
+	for _, reward := range rewards {
+		quantity := reward.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		backendType := strings.Split(reward.TemplateId, ":")[0]
+		itemProfile := "athena"
+
+		switch {
+			case strings.EqualFold(reward.TemplateId, "Currency:mtxgiveaway"):
+				result.VBucks += quantity
+				itemProfile = "common_core"
+			case strings.EqualFold(reward.TemplateId, "Token:athenaseasonxpboost"):
+				addToStat(athenaProfile, "season_match_boost", quantity)
+				modifiedStats = append(modifiedStats, "season_match_boost")
+			case strings.EqualFold(reward.TemplateId, "Token:athenaseasonfriendxpboost"):
+				addToStat(athenaProfile, "season_friend_match_boost", quantity)
+				modifiedStats = append(modifiedStats, "season_friend_match_boost")
+			case backendType == "ChallengeBundleSchedule":
+				// schedules are unlocked by the quest system once the pass is owned
+				continue
+			case backendType == "HomebaseBannerIcon":
+				itemProfile = "common_core"
+				if _, ok := commonCore.Items[reward.TemplateId]; ok {
+					continue
+				}
+
+				item := models.CommonCoreItem{
+					TemplateId: reward.TemplateId,
+					Attributes: map[string]any{
+						"item_seen": false,
+					},
+					Quantity: 1,
+				}
+				commonCore.Items[reward.TemplateId] = item
+				result.CommonCoreChanges = append(result.CommonCoreChanges, models.ProfileChange{
+					ChangeType: "itemAdded",
+					ItemID: reward.TemplateId,
+					Item: item,
+				})
+			default:
+				change, ok := grantAthenaReward(athenaProfile, reward.TemplateId, quantity)
+				if !ok {
+					continue
+				}
+				result.AthenaChanges = append(result.AthenaChanges, change)
+		}
+
+		result.LootItems = append(result.LootItems, models.LootResultItem{
+			ItemType: reward.TemplateId,
+			ItemGuid: reward.TemplateId,
+			ItemProfile: itemProfile,
+			Quantity: quantity,
+		})
+	}
+
+	seenStats := map[string]bool{}
+	for _, stat := range modifiedStats {
+		if seenStats[stat] {
+			continue
+		}
+		seenStats[stat] = true
+
+		result.AthenaChanges = append(result.AthenaChanges, models.ProfileChange{
+			ChangeType: "statModified",
+			Name: stat,
+			Value: athenaProfile.Stats.Attributes[stat],
+		})
+	}
+
+	if result.VBucks > 0 {
+		AddUserVBucks(accountId, commonCore, result.VBucks, VBucksReasonReward, "season" + strconv.Itoa(Season))
+	}
+
+	return result
+}
+
+func grantAthenaReward(athenaProfile *models.Profile, templateId string, quantity int) (models.ProfileChange, bool) {
+	if key, ok := findTemplateId(athenaProfile, templateId); ok {
+		// cosmetics can only be owned once, everything else stacks
+		if strings.HasPrefix(strings.ToLower(templateId), "athena") {
+			return models.ProfileChange{}, false
+		}
+
+		marshal, err := json.Marshal(athenaProfile.Items[key])
+		if err != nil {
+			return models.ProfileChange{}, false
+		}
+
+		var item models.Item
+		err = json.Unmarshal(marshal, &item)
+		if err != nil {
+			return models.ProfileChange{}, false
+		}
+
+		item.Quantity += quantity
+		athenaProfile.Items[key] = item
+
+		return models.ProfileChange{
+			ChangeType: "itemQuantityChanged",
+			ItemID: key,
+			Quantity: item.Quantity,
+		}, true
+	}
+
+	item := models.Item{
+		TemplateId: templateId,
+		Attributes: models.ItemAttributes{
+			Level: 1,
+			ItemSeen: false,
+			Variants: GetDefaultVariants(templateId),
+		},
+		Quantity: quantity,
+	}
+	athenaProfile.Items[templateId] = item
+
+	return models.ProfileChange{
+		ChangeType: "itemAdded",
+		ItemID: templateId,
+		Item: item,
+	}, true
+}
+
+func addToStat(profile *models.Profile, stat string, amount int) {
+	current, _ := StatToInt(profile.Stats.Attributes[stat])
+	profile.Stats.Attributes[stat] = current + amount
+}
@@ -0,0 +1,299 @@
+package common
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+func discountPrice(price int, percent int) int {
+	if percent <= 0 {
+		return price
+	}
+
+	if percent >= 100 {
+		return 0
+	}
+
+	return price * (100 - percent) / 100
+}
+
+// only items that can be priced make it into a bundle
+func GetBundleItems(set string) []models.BeforeStoreItem {
+	allSets, err := GetAllSets()
+	if err != nil {
+		return []models.BeforeStoreItem{}
+	}
+
+	items := []models.BeforeStoreItem{}
+	for _, templateId := range allSets[set] {
+		item, ok := GetItem(templateId)
+		if !ok {
+			continue
+		}
+
+		if !HasItemPrice(item) {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+func bundleTitle(set string, items []models.BeforeStoreItem) string {
+	for _, item := range items {
+		if item.BackendType == "AthenaCharacter" && item.Name != "" {
+			return item.Name + " Bundle"
+		}
+	}
+
+	return set + " Bundle"
+}
+
+func NewBundleCatalogEntry(set string, items []models.BeforeStoreItem, priority int, section models.ShopSectionConfig, expiration string) models.CatalogEntry {
+	id := all.HashString("bundle:" + set)
+
+	bundleInfo := models.DynamicBundleInfo{
+		DiscountedBasePrice: 0,
+		RegularBasePrice: 0,
+		FloorPrice: section.BundleFloor,
+		CurrencyType: "MtxCurrency",
+		CurrencySubType: "",
+		DisplayType: "AmountOff",
+		BundleItems: []models.DynamicBundleItem{},
+	}
+
+	itemGrants := []models.ItemGrant{}
+	regularPrice := 0
+	finalPrice := 0
+
+	for _, item := range items {
+		templateId := item.BackendType + ":" + item.ID
+		price := GetItemPrice(item)
+		discounted := discountPrice(price, section.BundleDiscount)
+
+		regularPrice += price
+		finalPrice += discounted
+
+		grant := models.ItemGrant{
+			TemplateID: templateId,
+			Quantity: 1,
+		}
+		itemGrants = append(itemGrants, grant)
+
+		bundleInfo.BundleItems = append(bundleInfo.BundleItems, models.DynamicBundleItem{
+			CanOwnMultiple: false,
+			RegularPrice: price,
+			DiscountedPrice: discounted,
+			AlreadyOwnedPriceReduction: discounted,
+			Item: grant,
+		})
+	}
+
+	if finalPrice < bundleInfo.FloorPrice {
+		finalPrice = bundleInfo.FloorPrice
+	}
+
+	return models.CatalogEntry{
+		DevName: id,
+		OfferID: id,
+		FulfillmentIDs: []string{},
+		DailyLimit: -1,
+		WeeklyLimit: -1,
+		MonthlyLimit: -1,
+		Categories: []string{},
+		Prices: []models.Price{{
+			CurrencyType: "MtxCurrency",
+			CurrencySubType: "CurrencySource",
+			RegularPrice: regularPrice,
+			FinalPrice: finalPrice,
+			SaleExpiration: expiration,
+			BasePrice: finalPrice,
+		}},
+		MatchFilter: "",
+		AppStoreID: []string{},
+		FilterWeight: priority,
+		SortPriority: priority,
+		CatalogGroupPriority: 0,
+		Refundable: section.Refundable,
+		DisplayAssetPath: "",
+		OfferType: "DynamicBundle",
+		// the price depends on what the receiver owns so bundles can't be gifted
+		GiftInfo: map[string]any {
+			"bIsEnabled": false,
+			"forcedGiftBoxTemplateId": "",
+			"purchaseRequirements": []any{},
+			"giftRecordIds": []any{},
+		},
+		Meta: map[string]any {
+			"SectionId": section.SectionId,
+			"TileSize": section.TileSize,
+		},
+		MetaInfo: []gin.H{
+			{
+				"key": "SectionId",
+				"value": section.SectionId,
+			}, {
+				"key": "TileSize",
+				"value": section.TileSize,
+			},
+		},
+		Requirements: []models.Requirement{},
+		ItemGrants: itemGrants,
+		Title: bundleTitle(set, items),
+		DynamicBundleInfo: &bundleInfo,
+	}
+}
+
+func generateShopBundles(random *rand.Rand, section models.ShopSectionConfig, skip map[string]bool, picked map[string]bool, priority int, expiration string) []models.CatalogEntry {
+	entries := []models.CatalogEntry{}
+
+	// older clients can't show dynamic bundles, so don't spend shop items on them
+	if section.Bundles < 1 || Season < DynamicBundleMinSeason {
+		return entries
+	}
+
+	allSets, err := GetAllSets()
+	if err != nil {
+		return entries
+	}
+
+	minItems := section.BundleMinItems
+	if minItems < 2 {
+		minItems = 2
+	}
+
+	// map order is random so the sets are sorted to keep the seed meaningful
+	setNames := make([]string, 0, len(allSets))
+	for set := range allSets {
+		setNames = append(setNames, set)
+	}
+	sort.Strings(setNames)
+
+	candidates := []string{}
+	candidateItems := map[string][]models.BeforeStoreItem{}
+	for _, set := range setNames {
+		items := GetBundleItems(set)
+
+		priced := 0
+		available := true
+		for _, item := range items {
+			if skip[item.BackendType + ":" + item.ID] {
+				available = false
+				break
+			}
+
+			if item.BackendType != "AthenaBackpack" {
+				priced++
+			}
+		}
+
+		if !available || priced < minItems {
+			continue
+		}
+
+		candidates = append(candidates, set)
+		candidateItems[set] = items
+	}
+
+	for len(entries) < section.Bundles && len(candidates) > 0 {
+		index := random.Intn(len(candidates))
+		set := candidates[index]
+		candidates = append(candidates[:index], candidates[index+1:]...)
+
+		items := candidateItems[set]
+		taken := false
+		for _, item := range items {
+			if picked[item.BackendType + ":" + item.ID] {
+				taken = true
+				break
+			}
+		}
+
+		if taken {
+			continue
+		}
+
+		for _, item := range items {
+			templateId := item.BackendType + ":" + item.ID
+			picked[templateId] = true
+			skip[templateId] = true
+		}
+
+		entries = append(entries, NewBundleCatalogEntry(set, items, priority - len(entries), section, expiration))
+	}
+
+	return entries
+}
+
+// profiles key items by template id or by guid, and the case of a template id isn't reliable
+func findTemplateId(profile *models.Profile, templateId string) (string, bool) {
+	if profile == nil {
+		return "", false
+	}
+
+	if _, ok := profile.Items[templateId]; ok {
+		return templateId, true
+	}
+
+	templateId = strings.ToLower(templateId)
+	for key, item := range profile.Items {
+		if strings.ToLower(ItemTemplateId(item)) == templateId {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+func ownsTemplateId(athenaProfile *models.Profile, templateId string) bool {
+	_, ok := findTemplateId(athenaProfile, templateId)
+	return ok
+}
+
+// dynamic bundles only charge for the items the player doesn't already own
+func GetOfferPrice(offer models.CatalogEntry, athenaProfile *models.Profile) int {
+	if len(offer.Prices) == 0 {
+		return 0
+	}
+
+	if offer.DynamicBundleInfo == nil {
+		return offer.Prices[0].FinalPrice
+	}
+
+	bundleInfo := offer.DynamicBundleInfo
+	price := bundleInfo.DiscountedBasePrice
+	for _, bundleItem := range bundleInfo.BundleItems {
+		price += bundleItem.DiscountedPrice
+
+		if !bundleItem.CanOwnMultiple && ownsTemplateId(athenaProfile, bundleItem.Item.TemplateID) {
+			price -= bundleItem.AlreadyOwnedPriceReduction
+		}
+	}
+
+	if price < bundleInfo.FloorPrice {
+		price = bundleInfo.FloorPrice
+	}
+
+	return price
+}
+
+func ownsWholeBundle(offer models.CatalogEntry, athenaProfile *models.Profile) bool {
+	if offer.DynamicBundleInfo == nil {
+		return false
+	}
+
+	for _, bundleItem := range offer.DynamicBundleInfo.BundleItems {
+		if !ownsTemplateId(athenaProfile, bundleItem.Item.TemplateID) {
+			return false
+		}
+	}
+
+	return true
+}
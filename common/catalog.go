@@ -0,0 +1,207 @@
+package common
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	DynamicBundleMinSeason int = 10
+
+	catalog atomic.Pointer[catalogSnapshot]
+	buildVersionPattern = regexp.MustCompile(`Release-(\d+)\.(\d+)`)
+	buildChangelistPattern = regexp.MustCompile(`-CL-(\d+)`)
+	seasonStorefrontPattern = regexp.MustCompile(`^BRSeason(\d+)$`)
+)
+
+type catalogOffer struct {
+	storefront string
+	entry models.CatalogEntry
+}
+
+// a snapshot is never changed after it is stored, a rotation swaps in a new one
+type catalogSnapshot struct {
+	page models.StorePage
+	etag string
+	offers map[string]catalogOffer
+	builds sync.Map
+}
+
+func pageETag(page models.StorePage) string {
+	marshal, err := json.Marshal(page)
+	if err != nil {
+		return ""
+	}
+
+	hash := sha1.Sum(marshal)
+	return hex.EncodeToString(hash[:])
+}
+
+func SetCatalog(page models.StorePage) {
+	snapshot := &catalogSnapshot{
+		page: page,
+		etag: pageETag(page),
+		offers: map[string]catalogOffer{},
+	}
+
+	for _, storefront := range page.Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			snapshot.offers[entry.OfferID] = catalogOffer{
+				storefront: storefront.Name,
+				entry: entry,
+			}
+		}
+	}
+
+	catalog.Store(snapshot)
+}
+
+func getCatalogSnapshot() *catalogSnapshot {
+	snapshot := catalog.Load()
+	if snapshot != nil {
+		return snapshot
+	}
+
+	// nothing has rotated yet so start from the last saved shop
+	SetCatalog(GetItemShop())
+	return catalog.Load()
+}
+
+func GetCatalog() models.StorePage {
+	return getCatalogSnapshot().page
+}
+
+func HasCatalog() bool {
+	snapshot := catalog.Load()
+	return snapshot != nil && len(snapshot.page.Storefronts) > 0
+}
+
+func GetCatalogEntry(offerId string) (models.CatalogEntry, error) {
+	offer, ok := getCatalogSnapshot().offers[offerId]
+	if !ok {
+		return models.CatalogEntry{}, fmt.Errorf("could not find catalog entry with offerId %s", offerId)
+	}
+
+	return ApplyShopSalesToEntry(offer.storefront, offer.entry, GetActiveShopSales(time.Now())), nil
+}
+
+func ParseClientBuild(userAgent string) (models.ClientBuild, bool) {
+	version := buildVersionPattern.FindStringSubmatch(userAgent)
+	if version == nil {
+		return models.ClientBuild{}, false
+	}
+
+	build := models.ClientBuild{}
+	build.Season, _ = strconv.Atoi(version[1])
+	build.Minor, _ = strconv.Atoi(version[2])
+
+	if changelist := buildChangelistPattern.FindStringSubmatch(userAgent); changelist != nil {
+		build.Changelist, _ = strconv.Atoi(changelist[1])
+	}
+
+	return build, true
+}
+
+// an item from a later season than the build has no assets in that client,
+// whatever the rotation is allowed to pick
+func entryRenderable(entry models.CatalogEntry, build models.ClientBuild) bool {
+	if entry.OfferType == "DynamicBundle" && build.Season < DynamicBundleMinSeason {
+		return false
+	}
+
+	for _, grant := range entry.ItemGrants {
+		item, ok := GetItem(grant.TemplateID)
+		if !ok {
+			continue
+		}
+
+		if item.IntroductionSeason > build.Season {
+			return false
+		}
+	}
+
+	return true
+}
+
+func FilterCatalogForBuild(page models.StorePage, build models.ClientBuild) models.StorePage {
+	storefronts := []models.Storefront{}
+	for _, storefront := range page.Storefronts {
+		// another season's battle pass points at assets this build doesn't have
+		if season := seasonStorefrontPattern.FindStringSubmatch(storefront.Name); season != nil && season[1] != strconv.Itoa(build.Season) {
+			continue
+		}
+
+		entries := []models.CatalogEntry{}
+		for _, entry := range storefront.CatalogEntries {
+			if entryRenderable(entry, build) {
+				entries = append(entries, entry)
+			}
+		}
+
+		storefronts = append(storefronts, models.Storefront{
+			Name: storefront.Name,
+			CatalogEntries: entries,
+		})
+	}
+	page.Storefronts = storefronts
+
+	return page
+}
+
+func salesETag(sales []models.ShopSale) string {
+	keys := []string{}
+	for _, sale := range sales {
+		keys = append(keys, strconv.Itoa(int(sale.ID)) + ":" + strconv.FormatInt(sale.UpdatedAt.UnixNano(), 10))
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// planned sales end on their own, so the etag has to change when one does
+func plannedSalesETag(page models.StorePage, now time.Time) string {
+	keys := []string{}
+	for _, storefront := range page.Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			if plannedSaleActive(entry, now) {
+				keys = append(keys, entry.OfferID)
+			}
+		}
+	}
+
+	return strings.Join(keys, ",")
+}
+
+// the catalog a client sees depends on the rotation, its build and the sales running right now
+func GetCatalogForClient(userAgent string) (models.StorePage, string) {
+	snapshot := getCatalogSnapshot()
+
+	page := snapshot.page
+	buildKey := "any"
+	if build, ok := ParseClientBuild(userAgent); ok {
+		buildKey = strconv.Itoa(build.Season)
+
+		cached, ok := snapshot.builds.Load(buildKey)
+		if !ok {
+			cached, _ = snapshot.builds.LoadOrStore(buildKey, FilterCatalogForBuild(snapshot.page, build))
+		}
+		page = cached.(models.StorePage)
+	}
+
+	now := time.Now()
+	sales := getActiveSalesSnapshot(now)
+	plannedKey := plannedSalesETag(page, now)
+	page = applyShopSales(page, sales.sales)
+
+	hash := sha1.Sum([]byte(snapshot.etag + ";" + buildKey + ";" + sales.etag + ";" + plannedKey))
+	return page, "\"" + hex.EncodeToString(hash[:]) + "\""
+}
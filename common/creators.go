@@ -0,0 +1,188 @@
+package common
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+	"gorm.io/gorm"
+)
+
+var (
+	CreatorCodeDuration = time.Hour * 24 * 14
+	creatorCodePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,16}$`)
+
+	ErrInvalidCreatorCode = errors.New("invalid creator code")
+	ErrCreatorCodeTaken = errors.New("creator code already exists")
+	ErrCreatorCodeNotFound = errors.New("creator code not found")
+)
+
+func NormalizeCreatorCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+func GetCreatorCode(code string) (models.CreatorCode, error) {
+	var creatorCode models.CreatorCode
+	result := all.Postgres.Where("code = ?", NormalizeCreatorCode(code)).First(&creatorCode)
+	if result.Error != nil {
+		return models.CreatorCode{}, ErrCreatorCodeNotFound
+	}
+
+	return creatorCode, nil
+}
+
+func GetAllCreatorCodes() []models.CreatorCode {
+	var creatorCodes []models.CreatorCode
+	all.Postgres.Order("code").Find(&creatorCodes)
+
+	return creatorCodes
+}
+
+func CreateCreatorCode(code string, accountId string, createdBy string) (models.CreatorCode, error) {
+	code = strings.TrimSpace(code)
+	if !creatorCodePattern.MatchString(code) {
+		return models.CreatorCode{}, ErrInvalidCreatorCode
+	}
+
+	if _, err := GetCreatorCode(code); err == nil {
+		return models.CreatorCode{}, ErrCreatorCodeTaken
+	}
+
+	creatorCode := models.CreatorCode{
+		Code: NormalizeCreatorCode(code),
+		DisplayName: code,
+		AccountId: accountId,
+		Enabled: true,
+		CreatedBy: createdBy,
+	}
+
+	result := all.Postgres.Create(&creatorCode)
+	if result.Error != nil {
+		return models.CreatorCode{}, result.Error
+	}
+
+	return creatorCode, nil
+}
+
+func SetCreatorCodeEnabled(code string, enabled bool) (models.CreatorCode, error) {
+	creatorCode, err := GetCreatorCode(code)
+	if err != nil {
+		return models.CreatorCode{}, err
+	}
+
+	creatorCode.Enabled = enabled
+	result := all.Postgres.Model(&creatorCode).Update("enabled", enabled)
+	if result.Error != nil {
+		return models.CreatorCode{}, result.Error
+	}
+
+	return creatorCode, nil
+}
+
+// an empty name removes the current creator
+func SetProfileAffiliate(profile *models.Profile, name string) ([]models.ProfileChange, error) {
+	affiliate := ""
+	affiliateId := ""
+	setTime := ""
+
+	if strings.TrimSpace(name) != "" {
+		creatorCode, err := GetCreatorCode(name)
+		if err != nil || !creatorCode.Enabled {
+			return nil, ErrInvalidCreatorCode
+		}
+
+		affiliate = creatorCode.DisplayName
+		affiliateId = creatorCode.AccountId
+		setTime = time.Now().Format("2006-01-02T15:04:05.999Z")
+	}
+
+	profile.Stats.Attributes["mtx_affiliate"] = affiliate
+	profile.Stats.Attributes["mtx_affiliate_id"] = affiliateId
+	profile.Stats.Attributes["mtx_affiliate_set_time"] = setTime
+
+	changes := []models.ProfileChange{}
+	for _, stat := range []string{"mtx_affiliate", "mtx_affiliate_id", "mtx_affiliate_set_time"} {
+		changes = append(changes, models.ProfileChange{
+			ChangeType: "statModified",
+			Name: stat,
+			Value: profile.Stats.Attributes[stat],
+		})
+	}
+
+	return changes, nil
+}
+
+// creators stop getting credit once the code is disabled or the player has
+// not entered it again within the support period
+func GetActiveAffiliate(profile *models.Profile) (models.CreatorCode, bool) {
+	name, _ := profile.Stats.Attributes["mtx_affiliate"].(string)
+	setTime, _ := profile.Stats.Attributes["mtx_affiliate_set_time"].(string)
+	if name == "" || setTime == "" {
+		return models.CreatorCode{}, false
+	}
+
+	parsedSetTime, err := time.Parse("2006-01-02T15:04:05.999Z", setTime)
+	if err != nil || time.Now().After(parsedSetTime.Add(CreatorCodeDuration)) {
+		return models.CreatorCode{}, false
+	}
+
+	creatorCode, err := GetCreatorCode(name)
+	if err != nil || !creatorCode.Enabled {
+		return models.CreatorCode{}, false
+	}
+
+	return creatorCode, true
+}
+
+func recordCreatorPurchase(db *gorm.DB, accountId string, commonCore *models.Profile, purchase *models.MtxPurchase) {
+	if purchase.TotalMtxPaid <= 0 {
+		return
+	}
+
+	creatorCode, ok := GetActiveAffiliate(commonCore)
+	if !ok {
+		return
+	}
+
+	purchase.Metadata["mtx_affiliate"] = creatorCode.DisplayName
+	purchase.Metadata["mtx_affiliate_id"] = creatorCode.AccountId
+
+	result := db.Create(&models.CreatorPurchase{
+		Code: creatorCode.Code,
+		AccountId: accountId,
+		PurchaseId: purchase.PurchaseId,
+		OfferId: purchase.OfferId,
+		Amount: purchase.TotalMtxPaid,
+	})
+	if result.Error != nil {
+		all.PrintRed([]any{"could not record creator purchase", purchase.PurchaseId, result.Error.Error()})
+	}
+}
+
+func refundCreatorPurchase(purchaseId string) {
+	all.Postgres.Model(&models.CreatorPurchase{}).Where("purchase_id = ?", purchaseId).Update("refunded", true)
+}
+
+func GetCreatorReport(code string, from time.Time, to time.Time) (models.CreatorReport, error) {
+	creatorCode, err := GetCreatorCode(code)
+	if err != nil {
+		return models.CreatorReport{}, err
+	}
+
+	report := models.CreatorReport{
+		Code: creatorCode.DisplayName,
+		From: from,
+		To: to,
+	}
+
+	query := all.Postgres.Model(&models.CreatorPurchase{}).Where("code = ? AND created_at >= ? AND created_at < ?", creatorCode.Code, from, to)
+	query.Session(&gorm.Session{}).Where("refunded = ?", false).Count(&report.Purchases)
+	query.Session(&gorm.Session{}).Where("refunded = ?", false).Distinct("account_id").Count(&report.Supporters)
+	query.Session(&gorm.Session{}).Where("refunded = ?", false).Select("COALESCE(SUM(amount), 0)").Scan(&report.VBucks)
+	query.Session(&gorm.Session{}).Where("refunded = ?", true).Select("COALESCE(SUM(amount), 0)").Scan(&report.Refunded)
+
+	return report, nil
+}
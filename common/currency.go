@@ -0,0 +1,175 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	VBucksReasonStarting = "starting_balance"
+	VBucksReasonDaily = "daily_login"
+	VBucksReasonPurchase = "purchase"
+	VBucksReasonGift = "gift"
+	VBucksReasonRefund = "refund"
+	VBucksReasonReward = "reward"
+	VBucksReasonAdmin = "admin"
+	VBucksReasonReconcile = "reconcile"
+
+	ErrNotEnoughVBucks = errors.New("not enough vbucks")
+)
+
+func sumVBucks(db *gorm.DB, accountId string) int {
+	var balance int
+	db.Model(&models.VBucksTransaction{}).Where("account_id = ?", accountId).Select("COALESCE(SUM(amount), 0)").Scan(&balance)
+
+	return balance
+}
+
+// accounts from before the ledger only have users.v_bucks, it becomes the
+// opening entry the first time the ledger is read or written
+func openVBucksLedger(tx *gorm.DB, accountId string) (models.User, error) {
+	var user models.User
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("account_id = ?", accountId).First(&user)
+	if result.Error != nil {
+		return models.User{}, result.Error
+	}
+
+	var count int64
+	tx.Model(&models.VBucksTransaction{}).Where("account_id = ?", accountId).Count(&count)
+	if count != 0 || user.VBucks == 0 {
+		return user, nil
+	}
+
+	result = tx.Create(&models.VBucksTransaction{
+		AccountId: accountId,
+		Amount: user.VBucks,
+		Balance: user.VBucks,
+		Reason: VBucksReasonReconcile,
+		Reference: "opening_balance",
+	})
+
+	return user, result.Error
+}
+
+func GetVBucksBalance(accountId string) int {
+	var count int64
+	all.Postgres.Model(&models.VBucksTransaction{}).Where("account_id = ?", accountId).Count(&count)
+
+	if count == 0 {
+		err := all.Postgres.Transaction(func(tx *gorm.DB) error {
+			_, err := openVBucksLedger(tx, accountId)
+			return err
+		})
+		if err != nil {
+			all.PrintRed([]any{"could not open vbucks ledger for", accountId, err.Error()})
+		}
+	}
+
+	return sumVBucks(all.Postgres, accountId)
+}
+
+// the ledger is the only place v-bucks are stored, users.v_bucks and the
+// Currency:MtxPurchased item are copies that get rewritten after every change
+func RecordVBucksTransaction(accountId string, amount int, reason string, reference string) (int, error) {
+	balance := 0
+
+	err := all.Postgres.Transaction(func(tx *gorm.DB) error {
+		var err error
+		balance, err = recordVBucksTransaction(tx, accountId, amount, reason, reference)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	all.PrintGreen([]any{"recorded", amount, "vbucks for", accountId, "reason", reason, "balance", balance})
+
+	return balance, nil
+}
+
+// must run inside a transaction so the row lock is held until commit
+func recordVBucksTransaction(tx *gorm.DB, accountId string, amount int, reason string, reference string) (int, error) {
+	_, err := openVBucksLedger(tx, accountId)
+	if err != nil {
+		return 0, err
+	}
+
+	balance := sumVBucks(tx, accountId) + amount
+	if balance < 0 {
+		return 0, ErrNotEnoughVBucks
+	}
+
+	result := tx.Create(&models.VBucksTransaction{
+		AccountId: accountId,
+		Amount: amount,
+		Balance: balance,
+		Reason: reason,
+		Reference: reference,
+	})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	err = tx.Model(&models.User{}).Where("account_id = ?", accountId).Update("v_bucks", balance).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+func SetVBucksItem(profile *models.Profile, balance int) {
+	profile.Items["Currency:MtxPurchased"] = models.CommonCoreItem{
+		TemplateId: "Currency:MtxPurchased",
+		Attributes: map[string]any {
+			"platform": "EpicPC",
+		},
+		Quantity: balance,
+	}
+}
+
+func SyncVBucksItem(accountId string, profile *models.Profile) int {
+	balance := GetVBucksBalance(accountId)
+	SetVBucksItem(profile, balance)
+
+	return balance
+}
+
+func GetVBucksHistory(accountId string) []models.VBucksTransaction {
+	var transactions []models.VBucksTransaction
+	all.Postgres.Where("account_id = ?", accountId).Order("id").Find(&transactions)
+
+	return transactions
+}
+
+func ReconcileVBucks() (int, int) {
+	var users []models.User
+	all.Postgres.Find(&users)
+
+	reconciled := 0
+	failed := 0
+	for _, user := range users {
+		if user.AccountId == "" {
+			continue
+		}
+
+		balance := GetVBucksBalance(user.AccountId)
+		all.Postgres.Model(&models.User{}).Where("account_id = ?", user.AccountId).Update("v_bucks", balance)
+
+		commonCore, err := ReadProfileFromUser(user.AccountId, "common_core")
+		if err != nil {
+			failed++
+			continue
+		}
+
+		SetVBucksItem(&commonCore, balance)
+		SaveProfileToUser(user.AccountId, commonCore)
+		reconciled++
+	}
+
+	return reconciled, failed
+}
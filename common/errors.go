@@ -1,6 +1,7 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gin-gonic/gin"
@@ -57,4 +58,42 @@ func ErrorUnauthorized(c *gin.Context) {
 
 func ErrorUserAlreadyHasItem(c *gin.Context) {
 	DefaultEpicError(c, "errors.com.epicgames.common.item_has_been_granted", "User already has item", 28004, "", 400)
+}
+
+func ErrorPurchaseNotAllowed(c *gin.Context) {
+	DefaultEpicError(c, "errors.com.epicgames.modules.gamesubcatalog.purchase_not_allowed", "Could not purchase catalog offer", 28001, "", 400)
+}
+
+func ErrorPurchaseLimitReached(c *gin.Context) {
+	DefaultEpicError(c, "errors.com.epicgames.modules.gamesubcatalog.purchase_limit_reached", "Purchase limit reached for this offer", 28002, "", 400)
+}
+
+func ErrorCatalogRequirement(c *gin.Context, err error) {
+	switch {
+		case errors.Is(err, ErrRequirementOwnsItem):
+			ErrorUserAlreadyHasItem(c)
+		case errors.Is(err, ErrDailyLimitReached), errors.Is(err, ErrWeeklyLimitReached), errors.Is(err, ErrMonthlyLimitReached):
+			ErrorPurchaseLimitReached(c)
+		default:
+			ErrorPurchaseNotAllowed(c)
+	}
+}
+
+func ErrorInvalidAffiliate(c *gin.Context) {
+	DefaultEpicError(c, "errors.com.epicgames.modules.profile.affiliate_not_found", "Could not find a creator with that name", 12813, "", 404)
+}
+
+func ErrorGift(c *gin.Context, err error) {
+	switch {
+		case errors.Is(err, ErrGiftDailyLimitReached):
+			DefaultEpicError(c, "errors.com.epicgames.modules.gamesubcatalog.gift_limit_reached", "You have reached the daily gift limit", 28003, "", 400)
+		case errors.Is(err, ErrGiftNotFriends), errors.Is(err, ErrGiftFriendshipTooNew), errors.Is(err, ErrGiftToSelf):
+			DefaultEpicError(c, "errors.com.epicgames.modules.gamesubcatalog.gift_recipient_not_eligible", "You can not send gifts to this player yet", 28005, "", 400)
+		case errors.Is(err, ErrGiftReceivingDisabled), errors.Is(err, ErrGiftSendingDisabled), errors.Is(err, ErrGiftOfferNotGiftable):
+			DefaultEpicError(c, "errors.com.epicgames.modules.gamesubcatalog.gifting_disabled", "Gifting is not available", 28006, "", 400)
+		case errors.Is(err, ErrNotEnoughVBucks):
+			ErrorBadRequest(c)
+		default:
+			ErrorCatalogRequirement(c, err)
+	}
 }
\ No newline at end of file
@@ -138,6 +138,17 @@ func IsFriend(accountId string, friendId string) bool {
 	return len(friendActions) > 0 || len(friendActions2) > 0
 }
 
+func GetFriendsSince(accountId string, friendId string) (time.Time, bool) {
+	var friendAction models.FriendAction
+	result := all.Postgres.Where("((for_account_id = ? AND account_id = ?) OR (for_account_id = ? AND account_id = ?)) AND action = ?", accountId, friendId, friendId, accountId, "ACCEPTED").First(&friendAction)
+	if result.Error != nil {
+		return time.Time{}, false
+	}
+
+	// the request row is updated when it gets accepted
+	return friendAction.UpdatedAt, true
+}
+
 func IsBlocked(accountId string, friendId string) bool {
 	var friendActions []models.FriendAction
 	all.Postgres.Find(&friendActions, "for_account_id = ? AND account_id = ? AND action = ?", accountId, friendId, "BLOCKED")
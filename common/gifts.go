@@ -0,0 +1,364 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+	"gorm.io/gorm"
+)
+
+var (
+	DefaultGiftWrapTemplateId = "GiftBox:gb_default"
+
+	ErrGiftNoRecipients = errors.New("no gift recipients")
+	ErrGiftToSelf = errors.New("can not gift to yourself")
+	ErrGiftSendingDisabled = errors.New("sender is not allowed to send gifts")
+	ErrGiftReceivingDisabled = errors.New("recipient is not allowed to receive gifts")
+	ErrGiftOfferNotGiftable = errors.New("offer can not be gifted")
+	ErrGiftNotFriends = errors.New("recipient is not a friend")
+	ErrGiftFriendshipTooNew = errors.New("friendship is too new to send gifts")
+	ErrGiftDailyLimitReached = errors.New("daily gift limit reached")
+)
+
+type giftRecipient struct {
+	accountId string
+	athenaProfile models.Profile
+	commonCore models.Profile
+}
+
+func NewGiftBox(templateId string, fromAccountId string, lootList []models.LootResultItem, message string) models.CommonCoreItem {
+	if templateId == "" {
+		templateId = DefaultGiftWrapTemplateId
+	}
+
+	if lootList == nil {
+		lootList = []models.LootResultItem{}
+	}
+
+	return models.CommonCoreItem{
+		TemplateId: templateId,
+		Attributes: map[string]any{
+			"fromAccountId": fromAccountId,
+			"lootList": lootList,
+			"params": map[string]any{
+				"userMessage": message,
+			},
+			"level": 1,
+			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
+		},
+		Quantity: 1,
+	}
+}
+
+// every gift box gets its own id so unopened gifts stack up instead of
+// replacing each other
+func AddGiftBoxToProfile(commonCore *models.Profile, giftBox models.CommonCoreItem) string {
+	giftBoxId := uuid.New().String()
+	commonCore.Items[giftBoxId] = giftBox
+
+	return giftBoxId
+}
+
+func SendServerGiftBox(accountId string, lootList []models.LootResultItem, message string) (string, error) {
+	commonCore, err := ReadProfileFromUser(accountId, "common_core")
+	if err != nil {
+		return "", err
+	}
+
+	giftBoxId := AddGiftBoxToProfile(&commonCore, NewGiftBox("", "Server", lootList, message))
+
+	return giftBoxId, SaveProfileToUser(accountId, commonCore)
+}
+
+func IsGiftBox(item any) bool {
+	return strings.HasPrefix(ItemTemplateId(item), "GiftBox:")
+}
+
+func RemoveGiftBoxes(commonCore *models.Profile, giftBoxIds []string) []models.ProfileChange {
+	changes := []models.ProfileChange{}
+	for _, giftBoxId := range giftBoxIds {
+		item, ok := commonCore.Items[giftBoxId]
+		if !ok || !IsGiftBox(item) {
+			continue
+		}
+
+		delete(commonCore.Items, giftBoxId)
+		changes = append(changes, models.ProfileChange{
+			ChangeType: "itemRemoved",
+			ItemID: giftBoxId,
+		})
+	}
+
+	return changes
+}
+
+func SendAdminGift(accountId string, rewards []models.BattlePassReward, message string, templateId string) (string, error) {
+	athenaProfile, err := ReadProfileFromUser(accountId, "athena")
+	if err != nil {
+		return "", err
+	}
+
+	commonCore, err := ReadProfileFromUser(accountId, "common_core")
+	if err != nil {
+		return "", err
+	}
+
+	granted := GrantBattlePassRewards(&athenaProfile, &commonCore, rewards, accountId)
+	giftBoxId := AddGiftBoxToProfile(&commonCore, NewGiftBox(templateId, "Server", granted.LootItems, message))
+
+	err = SaveProfileToUser(accountId, athenaProfile)
+	if err != nil {
+		return "", err
+	}
+
+	return giftBoxId, SaveProfileToUser(accountId, commonCore)
+}
+
+func GetGiftHistory(profile *models.Profile) models.GiftHistory {
+	history := models.GiftHistory{}
+
+	if raw, ok := profile.Stats.Attributes["gift_history"]; ok {
+		marshal, err := json.Marshal(raw)
+		if err == nil {
+			json.Unmarshal(marshal, &history)
+		}
+	}
+
+	if history.SentTo == nil {
+		history.SentTo = map[string]string{}
+	}
+
+	if history.ReceivedFrom == nil {
+		history.ReceivedFrom = map[string]string{}
+	}
+
+	if history.Gifts == nil {
+		history.Gifts = []models.GiftHistoryEntry{}
+	}
+
+	return history
+}
+
+func SetGiftHistory(profile *models.Profile, history models.GiftHistory) {
+	profile.Stats.Attributes["gift_history"] = history
+}
+
+func CountGiftsSentSince(profile *models.Profile, since time.Time) int {
+	count := 0
+	for _, gift := range GetGiftHistory(profile).Gifts {
+		if gift.ToAccountId == "" {
+			continue
+		}
+
+		date, err := time.Parse("2006-01-02T15:04:05.999Z", gift.Date)
+		if err != nil || date.Before(since) {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func statAllows(profile *models.Profile, stat string) bool {
+	allowed, ok := profile.Stats.Attributes[stat].(bool)
+	return !ok || allowed
+}
+
+func IsOfferGiftable(offer models.CatalogEntry) bool {
+	enabled, _ := offer.GiftInfo["bIsEnabled"].(bool)
+	return enabled
+}
+
+func CheckGiftSender(senderCommonCore *models.Profile, offer models.CatalogEntry, recipients int) error {
+	if recipients < 1 {
+		return ErrGiftNoRecipients
+	}
+
+	if !statAllows(senderCommonCore, "allowed_to_send_gifts") {
+		return ErrGiftSendingDisabled
+	}
+
+	if !IsOfferGiftable(offer) {
+		return ErrGiftOfferNotGiftable
+	}
+
+	if CountGiftsSentSince(senderCommonCore, time.Now().Add(-time.Hour * 24)) + recipients > MaxDailyGifts {
+		return ErrGiftDailyLimitReached
+	}
+
+	return nil
+}
+
+func loadGiftRecipient(senderId string, offer models.CatalogEntry, accountId string) (giftRecipient, error) {
+	if senderId == accountId {
+		return giftRecipient{}, ErrGiftToSelf
+	}
+
+	friendsSince, ok := GetFriendsSince(senderId, accountId)
+	if !ok || IsBlocked(senderId, accountId) {
+		return giftRecipient{}, ErrGiftNotFriends
+	}
+
+	if time.Since(friendsSince) < MinGiftFriendshipAge {
+		return giftRecipient{}, ErrGiftFriendshipTooNew
+	}
+
+	athenaProfile, err := ReadProfileFromUser(accountId, "athena")
+	if err != nil {
+		return giftRecipient{}, err
+	}
+
+	commonCore, err := ReadProfileFromUser(accountId, "common_core")
+	if err != nil {
+		return giftRecipient{}, err
+	}
+
+	if !statAllows(&commonCore, "allowed_to_receive_gifts") {
+		return giftRecipient{}, ErrGiftReceivingDisabled
+	}
+
+	// purchase limits belong to whoever ends up with the items, not to the sender
+	err = CheckCatalogEntryPurchase(offer, &athenaProfile, &commonCore, 1)
+	if err != nil {
+		return giftRecipient{}, err
+	}
+
+	return giftRecipient{
+		accountId: accountId,
+		athenaProfile: athenaProfile,
+		commonCore: commonCore,
+	}, nil
+}
+
+func CheckGiftRecipient(senderId string, offer models.CatalogEntry, accountId string) error {
+	_, err := loadGiftRecipient(senderId, offer, accountId)
+	return err
+}
+
+func UniqueGiftReceivers(receiverIds []string) []string {
+	uniqueReceivers := []string{}
+	seenReceivers := map[string]bool{}
+	for _, receiverId := range receiverIds {
+		if seenReceivers[receiverId] {
+			continue
+		}
+		seenReceivers[receiverId] = true
+		uniqueReceivers = append(uniqueReceivers, receiverId)
+	}
+
+	return uniqueReceivers
+}
+
+// every recipient is checked before anything is charged, then the charge and
+// all deliveries are committed together so a failure leaves nobody changed
+func SendGift(senderId string, senderCommonCore *models.Profile, offer models.CatalogEntry, receiverIds []string, giftWrapTemplateId string, message string) (int, error) {
+	uniqueReceivers := UniqueGiftReceivers(receiverIds)
+
+	err := CheckGiftSender(senderCommonCore, offer, len(uniqueReceivers))
+	if err != nil {
+		return 0, err
+	}
+
+	recipients := []giftRecipient{}
+	for _, receiverId := range uniqueReceivers {
+		recipient, err := loadGiftRecipient(senderId, offer, receiverId)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", receiverId, err)
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	price := offer.Prices[0].FinalPrice
+	now := time.Now().Format("2006-01-02T15:04:05.999Z")
+	senderHistory := GetGiftHistory(senderCommonCore)
+	balance := 0
+
+	err = all.Postgres.Transaction(func(tx *gorm.DB) error {
+		var err error
+		balance, err = recordVBucksTransaction(tx, senderId, -price * len(recipients), VBucksReasonGift, offer.OfferID + ":" + strconv.Itoa(len(recipients)))
+		if err != nil {
+			return err
+		}
+
+		for _, recipient := range recipients {
+			lootList := []models.LootResultItem{}
+
+			for _, grant := range offer.ItemGrants {
+				lootList = append(lootList, models.LootResultItem{
+					ItemType: grant.TemplateID,
+					ItemGuid: grant.TemplateID,
+					ItemProfile: "athena",
+					Quantity: 1,
+				})
+
+				if _, ok := recipient.athenaProfile.Items[grant.TemplateID]; ok {
+					continue
+				}
+				AddItemToProfile(&recipient.athenaProfile, grant.TemplateID, recipient.accountId)
+			}
+
+			giftBoxId := AddGiftBoxToProfile(&recipient.commonCore, NewGiftBox(giftWrapTemplateId, senderId, lootList, message))
+
+			recipientHistory := GetGiftHistory(&recipient.commonCore)
+			recipientHistory.NumReceived += 1
+			recipientHistory.ReceivedFrom[senderId] = now
+			recipientHistory.Gifts = append(recipientHistory.Gifts, models.GiftHistoryEntry{
+				Date: now,
+				OfferId: offer.OfferID,
+				FromAccountId: senderId,
+				GiftBoxId: giftBoxId,
+			})
+			SetGiftHistory(&recipient.commonCore, recipientHistory)
+
+			senderHistory.NumSent += 1
+			senderHistory.SentTo[recipient.accountId] = now
+			senderHistory.Gifts = append(senderHistory.Gifts, models.GiftHistoryEntry{
+				Date: now,
+				OfferId: offer.OfferID,
+				ToAccountId: recipient.accountId,
+				GiftBoxId: giftBoxId,
+			})
+
+			for _, profile := range []*models.Profile{&recipient.athenaProfile, &recipient.commonCore} {
+				profile.Rvn += 1
+				profile.CommandRevision = profile.Rvn
+				profile.Updated = now
+
+				err = saveProfileWith(tx, recipient.accountId, *profile)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// gifts can't be refunded, the items already belong to someone else
+		giftOffer := offer
+		giftOffer.Refundable = false
+		giftOffer.FulfillmentIDs = []string{}
+		recordMtxPurchaseWith(tx, senderId, senderCommonCore, giftOffer, price * len(recipients), len(recipients), []models.LootResultItem{}, map[string]any{
+			"gift": true,
+		})
+
+		SetGiftHistory(senderCommonCore, senderHistory)
+		SetVBucksItem(senderCommonCore, balance)
+
+		return saveProfileWith(tx, senderId, *senderCommonCore)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	all.PrintGreen([]any{senderId, "gifted", offer.OfferID, "to", len(recipients), "players"})
+
+	return balance, nil
+}
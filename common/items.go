@@ -0,0 +1,261 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var (
+	ItemDatabasePath = "data/shop/all.json"
+
+	itemDatabase atomic.Pointer[itemIndex]
+	itemDatabaseMutex sync.Mutex
+	itemDatabaseFailed time.Time
+)
+
+// an index is never changed after it is stored, a reload swaps in a new one
+type itemIndex struct {
+	items []models.BeforeStoreItem
+	byTemplateId map[string]int
+	byType map[string][]int
+	byRarity map[string][]int
+	bySet map[string][]int
+	bySeason map[int][]int
+	modified time.Time
+}
+
+func newItemIndex(items []models.BeforeStoreItem, modified time.Time) *itemIndex {
+	index := &itemIndex{
+		items: []models.BeforeStoreItem{},
+		byTemplateId: map[string]int{},
+		byType: map[string][]int{},
+		byRarity: map[string][]int{},
+		bySet: map[string][]int{},
+		bySeason: map[int][]int{},
+		modified: modified,
+	}
+
+	for _, item := range items {
+		// the first entry of all.json only holds comments
+		if item.ID == "" || item.BackendType == "" {
+			continue
+		}
+
+		i := len(index.items)
+		index.items = append(index.items, item)
+		index.byTemplateId[strings.ToLower(item.BackendType + ":" + item.ID)] = i
+		index.byType[strings.ToLower(item.BackendType)] = append(index.byType[strings.ToLower(item.BackendType)], i)
+		index.byRarity[strings.ToLower(item.Rarity)] = append(index.byRarity[strings.ToLower(item.Rarity)], i)
+		index.bySeason[item.IntroductionSeason] = append(index.bySeason[item.IntroductionSeason], i)
+
+		if item.Set != "" {
+			index.bySet[strings.ToLower(item.Set)] = append(index.bySet[strings.ToLower(item.Set)], i)
+		}
+	}
+
+	return index
+}
+
+func LoadItemDatabase() error {
+	itemDatabaseMutex.Lock()
+	defer itemDatabaseMutex.Unlock()
+
+	return loadItemDatabase()
+}
+
+func loadItemDatabase() error {
+	file, err := os.Open(ItemDatabasePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	var items []models.BeforeStoreItem
+	err = json.Unmarshal([]byte(str), &items)
+	if err != nil {
+		return err
+	}
+
+	itemDatabase.Store(newItemIndex(items, stat.ModTime()))
+	return nil
+}
+
+func getItemIndex() *itemIndex {
+	index := itemDatabase.Load()
+	if index != nil {
+		return index
+	}
+
+	itemDatabaseMutex.Lock()
+	defer itemDatabaseMutex.Unlock()
+
+	if index = itemDatabase.Load(); index != nil {
+		return index
+	}
+
+	err := loadItemDatabase()
+	if err != nil {
+		all.PrintRed([]any{"could not load item database", err.Error()})
+		itemDatabase.Store(newItemIndex([]models.BeforeStoreItem{}, time.Time{}))
+	}
+
+	return itemDatabase.Load()
+}
+
+// the returned slice is shared with the index and must not be changed
+func GetItems() []models.BeforeStoreItem {
+	return getItemIndex().items
+}
+
+func GetItem(templateId string) (models.BeforeStoreItem, bool) {
+	index := getItemIndex()
+
+	i, ok := index.byTemplateId[strings.ToLower(templateId)]
+	if !ok {
+		return models.BeforeStoreItem{}, false
+	}
+
+	return index.items[i], true
+}
+
+func NewItemInfo(item models.BeforeStoreItem) models.ItemInfo {
+	return models.ItemInfo{
+		TemplateId: item.BackendType + ":" + item.ID,
+		Id: item.ID,
+		Type: item.BackendType,
+		Rarity: item.Rarity,
+		Series: item.Series,
+		Season: item.IntroductionSeason,
+		Set: item.Set,
+		Name: item.Name,
+		Gender: item.Gender,
+	}
+}
+
+// the smallest index that matches is walked and the other filters are checked per item
+func SearchItems(query models.ItemQuery) models.ItemSearchResult {
+	index := getItemIndex()
+
+	candidates := []int(nil)
+	narrow := func(matches []int) {
+		if candidates == nil || len(matches) < len(candidates) {
+			candidates = matches
+		}
+	}
+
+	if query.Type != "" {
+		narrow(index.byType[strings.ToLower(query.Type)])
+	}
+
+	if query.Rarity != "" {
+		narrow(index.byRarity[strings.ToLower(query.Rarity)])
+	}
+
+	if query.Set != "" {
+		narrow(index.bySet[strings.ToLower(query.Set)])
+	}
+
+	if query.Season > 0 {
+		narrow(index.bySeason[query.Season])
+	}
+
+	if candidates == nil {
+		candidates = make([]int, len(index.items))
+		for i := range index.items {
+			candidates[i] = i
+		}
+	}
+
+	search := strings.ToLower(strings.TrimSpace(query.Search))
+	result := models.ItemSearchResult{
+		Items: []models.ItemInfo{},
+	}
+
+	for _, i := range candidates {
+		item := index.items[i]
+
+		if query.Type != "" && !strings.EqualFold(item.BackendType, query.Type) {
+			continue
+		}
+
+		if query.Rarity != "" && !strings.EqualFold(item.Rarity, query.Rarity) {
+			continue
+		}
+
+		if query.Set != "" && !strings.EqualFold(item.Set, query.Set) {
+			continue
+		}
+
+		if query.Season > 0 && item.IntroductionSeason != query.Season {
+			continue
+		}
+
+		if search != "" && !strings.Contains(strings.ToLower(item.Name), search) && !strings.Contains(strings.ToLower(item.ID), search) {
+			continue
+		}
+
+		result.Total++
+		if result.Total <= query.Offset || len(result.Items) >= query.Limit {
+			continue
+		}
+
+		result.Items = append(result.Items, NewItemInfo(item))
+	}
+
+	return result
+}
+
+func reloadItemDatabaseIfChanged() {
+	stat, err := os.Stat(ItemDatabasePath)
+	if err != nil {
+		return
+	}
+
+	if stat.ModTime().Equal(getItemIndex().modified) || stat.ModTime().Equal(itemDatabaseFailed) {
+		return
+	}
+
+	err = LoadItemDatabase()
+	if err != nil {
+		// the old items stay until the file is saved again
+		itemDatabaseFailed = stat.ModTime()
+		all.PrintRed([]any{"could not reload item database", err.Error()})
+		return
+	}
+
+	all.PrintGreen([]any{"reloaded item database", len(GetItems()), "items"})
+
+	if err := ValidatePriceTable(); err != nil {
+		all.PrintRed([]any{"item database has unpriced items", err.Error()})
+	}
+}
+
+// all.json is checked for changes so new items show up without a restart
+func WatchItemDatabase(interval time.Duration) {
+	go func() {
+		for {
+			time.Sleep(interval)
+			reloadItemDatabaseIfChanged()
+		}
+	}()
+}
@@ -0,0 +1,217 @@
+package common
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	keychain models.Keychain
+	keychainLoaded bool
+	keychainMutex sync.Mutex
+	keychainGuidPattern = regexp.MustCompile(`^[0-9A-F]{32}$`)
+
+	ErrKeychainInvalidGuid = errors.New("keychain guid must be 32 hex characters")
+	ErrKeychainInvalidKey = errors.New("keychain key must be a base64 encoded 32 byte key")
+	ErrKeychainKeyExists = errors.New("keychain key already exists")
+	ErrKeychainKeyNotFound = errors.New("keychain key not found")
+)
+
+func loadKeychain() error {
+	if keychainLoaded {
+		return nil
+	}
+
+	file, err := os.Open("data/keychain.json")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	err = json.Unmarshal([]byte(str), &keychain)
+	if err != nil {
+		return err
+	}
+
+	keychainLoaded = true
+	return nil
+}
+
+// one key per line keeps the file easy to diff by hand
+func saveKeychain() error {
+	lines := []string{"{", "  \"keys\": ["}
+
+	for i, key := range keychain.Keys {
+		marshal, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+
+		line := "    " + strings.NewReplacer("\",\"", "\", \"", "\":", "\": ", "],\"", "], \"").Replace(string(marshal))
+		if i < len(keychain.Keys) - 1 {
+			line += ","
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "  ]", "}", "")
+
+	return os.WriteFile("data/keychain.json", []byte(strings.Join(lines, "\n")), 0755)
+}
+
+func GetKeychainKeys() ([]models.KeychainKey, error) {
+	keychainMutex.Lock()
+	defer keychainMutex.Unlock()
+
+	err := loadKeychain()
+	if err != nil {
+		return []models.KeychainKey{}, err
+	}
+
+	keys := make([]models.KeychainKey, len(keychain.Keys))
+	copy(keys, keychain.Keys)
+
+	return keys, nil
+}
+
+func AddKeychainKey(key models.KeychainKey) (models.KeychainKey, error) {
+	key.Guid = strings.ToUpper(strings.TrimSpace(key.Guid))
+	key.Key = strings.TrimSpace(key.Key)
+	key.Cosmetic = strings.TrimSpace(key.Cosmetic)
+
+	if !keychainGuidPattern.MatchString(key.Guid) {
+		return models.KeychainKey{}, ErrKeychainInvalidGuid
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(key.Key); err != nil || len(decoded) != 32 {
+		return models.KeychainKey{}, ErrKeychainInvalidKey
+	}
+
+	keychainMutex.Lock()
+	defer keychainMutex.Unlock()
+
+	err := loadKeychain()
+	if err != nil {
+		return models.KeychainKey{}, err
+	}
+
+	for _, existing := range keychain.Keys {
+		if existing.Guid == key.Guid && strings.EqualFold(existing.Cosmetic, key.Cosmetic) {
+			return models.KeychainKey{}, ErrKeychainKeyExists
+		}
+	}
+
+	keychain.Keys = append(keychain.Keys, key)
+	return key, saveKeychain()
+}
+
+func RemoveKeychainKey(guid string, cosmetic string) error {
+	keychainMutex.Lock()
+	defer keychainMutex.Unlock()
+
+	err := loadKeychain()
+	if err != nil {
+		return err
+	}
+
+	keys := []models.KeychainKey{}
+	for _, key := range keychain.Keys {
+		if strings.EqualFold(key.Guid, guid) && strings.EqualFold(key.Cosmetic, cosmetic) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == len(keychain.Keys) {
+		return ErrKeychainKeyNotFound
+	}
+
+	keychain.Keys = keys
+	return saveKeychain()
+}
+
+func keychainKeyForBuild(key models.KeychainKey, build models.ClientBuild, buildKnown bool) bool {
+	if len(key.Builds) == 0 {
+		return true
+	}
+
+	if !buildKnown {
+		return false
+	}
+
+	season := strconv.Itoa(build.Season)
+	version := season + "." + strconv.Itoa(build.Minor)
+	for _, keyBuild := range key.Builds {
+		if keyBuild == season || keyBuild == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cosmetics are matched on the id after the backend type, the same way the keys name them
+func keychainCosmeticId(templateId string) string {
+	if index := strings.Index(templateId, ":"); index != -1 {
+		templateId = templateId[index+1:]
+	}
+
+	return strings.ToLower(templateId)
+}
+
+func GetKeychainForClient(userAgent string, athenaProfile *models.Profile) ([]string, error) {
+	keys, err := GetKeychainKeys()
+	if err != nil {
+		return []string{}, err
+	}
+
+	build, buildKnown := ParseClientBuild(userAgent)
+
+	cosmetics := map[string]bool{}
+	for _, storefront := range GetCatalog().Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			for _, grant := range entry.ItemGrants {
+				cosmetics[keychainCosmeticId(grant.TemplateID)] = true
+			}
+		}
+	}
+
+	if athenaProfile != nil {
+		for _, item := range athenaProfile.Items {
+			cosmetics[keychainCosmeticId(ItemTemplateId(item))] = true
+		}
+	}
+
+	response := []string{}
+	for _, key := range keys {
+		if !keychainKeyForBuild(key, build, buildKnown) {
+			continue
+		}
+
+		if key.Cosmetic == "" {
+			response = append(response, key.Guid + ":" + key.Key)
+			continue
+		}
+
+		if cosmetics[strings.ToLower(key.Cosmetic)] {
+			response = append(response, key.Guid + ":" + key.Key + ":" + key.Cosmetic)
+		}
+	}
+
+	return response, nil
+}
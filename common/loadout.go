@@ -0,0 +1,302 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+func GetLoadoutIdsFromProfile(profile *models.Profile) []string {
+	athenaProfile, err := ConvertProfileToAthena(*profile)
+	if err != nil {
+		return []string{}
+	}
+
+	return athenaProfile.Stats.Attributes.Loadouts
+}
+
+var (
+	// every athena profile is created with these two, the zombie loadout is the
+	// locker the client edits and anything after them is a saved preset
+	SandboxLoadoutId = "sandbox_loadout"
+	LockerLoadoutId = "zombie_loadout"
+)
+
+func IsBuiltInLoadout(loadoutId string) bool {
+	return loadoutId == SandboxLoadoutId || loadoutId == LockerLoadoutId
+}
+
+func LockerLoadoutIndex(loadoutIds []string) int {
+	for i, loadoutId := range loadoutIds {
+		if loadoutId == LockerLoadoutId {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func CountLoadoutPresetsForUser(accountId string) int64 {
+	var count int64
+	all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name NOT IN ?", accountId, []string{SandboxLoadoutId, LockerLoadoutId}).Count(&count)
+
+	return count
+}
+
+func CopyLoadout(loadout models.Loadout) (models.Loadout, error) {
+	marshal, err := json.Marshal(loadout)
+	if err != nil {
+		return models.Loadout{}, err
+	}
+
+	var newLoadout models.Loadout
+	err = json.Unmarshal(marshal, &newLoadout)
+	if err != nil {
+		return models.Loadout{}, err
+	}
+
+	return newLoadout, nil
+}
+
+func SaveNewLoadoutForUser(accountId string, loadoutId string, loadout models.Loadout) error {
+	if CountLoadoutPresetsForUser(accountId) >= int64(MaxLoadoutPresets) {
+		return errors.New("loadout preset limit reached")
+	}
+
+	marshal, err := json.Marshal(loadout)
+	if err != nil {
+		return err
+	}
+
+	result := all.Postgres.Create(&models.UserLoadout{
+		AccountId: accountId,
+		Loadout:   string(marshal),
+		LoadoutName: loadoutId,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	all.PrintGreen([]any{"created loadout preset", loadoutId, "for", accountId})
+
+	return nil
+}
+
+func DeleteLoadoutForUser(profile *models.Profile, loadoutId string, accountId string) error {
+	result := all.Postgres.Where("account_id = ? AND loadout_name = ?", accountId, loadoutId).Delete(&models.UserLoadout{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("loadout not found")
+	}
+
+	delete(profile.Items, loadoutId)
+	all.PrintYellow([]any{"deleted loadout preset", loadoutId, "for", accountId})
+
+	return nil
+}
+
+func ApplyLoadoutToFavorites(profile *models.Profile, loadout models.Loadout) {
+	firstItem := func(slotName string) string {
+		slot, ok := loadout.Attributes.LockerSlotsData.Slots[slotName]
+		if !ok || len(slot.Items) == 0 {
+			return ""
+		}
+
+		return slot.Items[0]
+	}
+
+	attributes := profile.Stats.Attributes
+	attributes["favorite_character"] = firstItem("Character")
+	attributes["favorite_backpack"] = firstItem("Backpack")
+	attributes["favorite_pickaxe"] = firstItem("Pickaxe")
+	attributes["favorite_glider"] = firstItem("Glider")
+	attributes["favorite_skydivecontrail"] = firstItem("SkyDiveContrail")
+	attributes["favorite_loadingscreen"] = firstItem("LoadingScreen")
+	attributes["favorite_musicpack"] = firstItem("MusicPack")
+
+	if slot, ok := loadout.Attributes.LockerSlotsData.Slots["Dance"]; ok {
+		attributes["favorite_dance"] = append([]string{}, slot.Items...)
+	}
+
+	if slot, ok := loadout.Attributes.LockerSlotsData.Slots["ItemWrap"]; ok {
+		attributes["favorite_itemwraps"] = append([]string{}, slot.Items...)
+	}
+
+	if loadout.Attributes.BannerIconTemplate != "" {
+		attributes["banner_icon"] = loadout.Attributes.BannerIconTemplate
+	}
+
+	if loadout.Attributes.BannerColorTemplate != "" {
+		attributes["banner_color"] = loadout.Attributes.BannerColorTemplate
+	}
+}
+
+func GetModularLoadoutPresets(profile *models.Profile) map[string]map[string]string {
+	presets := map[string]map[string]string{}
+
+	marshal, err := json.Marshal(profile.Stats.Attributes["loadout_presets"])
+	if err != nil {
+		return presets
+	}
+
+	json.Unmarshal(marshal, &presets)
+	if presets == nil {
+		presets = map[string]map[string]string{}
+	}
+
+	return presets
+}
+
+func ValidateLockerName(name string) error {
+	if len([]rune(name)) > 24 {
+		return errors.New("locker name is too long")
+	}
+
+	return nil
+}
+
+var (
+	RandomItemIds = map[string]string{
+		"character": "AthenaCharacter:cid_random",
+		"backpack": "AthenaBackpack:bid_random",
+		"pickaxe": "AthenaPickaxe:pickaxe_random",
+		"glider": "AthenaGlider:glider_random",
+		"skydivecontrail": "AthenaSkyDiveContrail:trails_random",
+		"loadingscreen": "AthenaLoadingScreen:lsid_random",
+		"musicpack": "AthenaMusicPack:musicpack_random",
+		"dance": "AthenaDance:eid_random",
+		"itemwrap": "AthenaItemWrap:wrap_random",
+	}
+	LockerSlotBackendTypes = map[string]string{
+		"Character": "AthenaCharacter",
+		"Backpack": "AthenaBackpack",
+		"Pickaxe": "AthenaPickaxe",
+		"Glider": "AthenaGlider",
+		"SkyDiveContrail": "AthenaSkyDiveContrail",
+		"LoadingScreen": "AthenaLoadingScreen",
+		"MusicPack": "AthenaMusicPack",
+		"Dance": "AthenaDance",
+		"ItemWrap": "AthenaItemWrap",
+	}
+)
+
+func IsRandomItem(itemId string) bool {
+	for _, randomItemId := range RandomItemIds {
+		if strings.EqualFold(itemId, randomItemId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsRandomItemForSlot(itemId string, slotName string) bool {
+	randomItemId, ok := RandomItemIds[strings.ToLower(slotName)]
+	if !ok {
+		return false
+	}
+
+	return strings.EqualFold(itemId, randomItemId)
+}
+
+func ItemTemplateId(item any) string {
+	switch v := item.(type) {
+		case models.Item:
+			return v.TemplateId
+		case models.CommonCoreItem:
+			return v.TemplateId
+		case map[string]any:
+			templateId, _ := v["templateId"].(string)
+			return templateId
+		default:
+			return ""
+	}
+}
+
+func GetOwnedItemsOfType(profile *models.Profile, backendType string) []string {
+	owned := []string{}
+	for _, item := range profile.Items {
+		templateId := ItemTemplateId(item)
+		if !strings.HasPrefix(templateId, backendType + ":") || IsRandomItem(templateId) {
+			continue
+		}
+
+		// sprays and emoji share the dance type but can not be picked as an emote
+		if backendType == "AthenaDance" && !strings.HasPrefix(strings.ToLower(templateId), "athenadance:eid_") {
+			continue
+		}
+
+		owned = append(owned, templateId)
+	}
+
+	sort.Strings(owned)
+	return owned
+}
+
+func ResolveRandomItemsInLoadout(profile *models.Profile, loadout *models.Loadout) {
+	for slotName, slot := range loadout.Attributes.LockerSlotsData.Slots {
+		backendType, ok := LockerSlotBackendTypes[slotName]
+		if !ok {
+			continue
+		}
+
+		choices := GetOwnedItemsOfType(profile, backendType)
+		for i, itemId := range slot.Items {
+			if !IsRandomItem(itemId) {
+				continue
+			}
+
+			if len(choices) == 0 {
+				slot.Items[i] = ""
+				continue
+			}
+
+			pick := rand.Intn(len(choices))
+			slot.Items[i] = choices[pick]
+
+			// the same emote should not be picked twice for one wheel
+			if slotName == "Dance" && len(choices) > 1 {
+				choices = append(choices[:pick], choices[pick+1:]...)
+			}
+		}
+
+		loadout.Attributes.LockerSlotsData.Slots[slotName] = slot
+	}
+}
+
+func ResolveRandomCosmetics(profile *models.Profile, accountId string) {
+	loadoutIds := GetLoadoutIdsFromProfile(profile)
+	if len(loadoutIds) == 0 {
+		return
+	}
+
+	activeLoadoutIndex, _ := StatToInt(profile.Stats.Attributes["active_loadout_index"])
+	if useRandomLoadout, _ := profile.Stats.Attributes["use_random_loadout"].(bool); useRandomLoadout && len(loadoutIds) > 1 {
+		activeLoadoutIndex = rand.Intn(len(loadoutIds) - 1) + 1
+	}
+
+	if activeLoadoutIndex < 0 || activeLoadoutIndex >= len(loadoutIds) {
+		return
+	}
+
+	activeLoadoutId := loadoutIds[activeLoadoutIndex]
+	loadout, err := GetLoadout(activeLoadoutId, accountId)
+	if err != nil {
+		return
+	}
+
+	ResolveRandomItemsInLoadout(profile, &loadout)
+	ApplyLoadoutToFavorites(profile, loadout)
+
+	profile.Items[activeLoadoutId] = loadout
+	profile.Stats.Attributes["active_loadout_index"] = activeLoadoutIndex
+	profile.Stats.Attributes["last_applied_loadout"] = activeLoadoutId
+}
@@ -0,0 +1,206 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+type ProfileMigration struct {
+	Version int
+	Name string
+	ProfileIds []string
+	Migrate func(profile *models.Profile, accountId string) error
+}
+
+// steps run in order and must never be reordered or removed once released,
+// add a new step with the next version instead of changing an old one
+var ProfileMigrations = []ProfileMigration{
+	{
+		Version: 1,
+		Name: "merge missing template stats and items",
+		Migrate: MigrateMergeProfileTemplate,
+	},
+	{
+		Version: 2,
+		Name: "grant default owned variants",
+		ProfileIds: []string{"athena"},
+		Migrate: MigrateDefaultVariants,
+	},
+	{
+		Version: 3,
+		Name: "backfill rolled season",
+		ProfileIds: []string{"athena"},
+		Migrate: MigrateRolledSeason,
+	},
+}
+
+func CurrentProfileSchemaVersion() int {
+	version := 0
+	for _, migration := range ProfileMigrations {
+		if migration.Version > version {
+			version = migration.Version
+		}
+	}
+
+	return version
+}
+
+func profileMigrationApplies(migration ProfileMigration, profileId string) bool {
+	if len(migration.ProfileIds) == 0 {
+		return true
+	}
+
+	for _, id := range migration.ProfileIds {
+		if id == profileId {
+			return true
+		}
+	}
+
+	return false
+}
+
+func MigrateProfile(userProfile *models.UserProfile, profile *models.Profile) error {
+	var migrated models.Profile
+	err := json.Unmarshal([]byte(userProfile.Profile), &migrated)
+	if err != nil {
+		return err
+	}
+
+	if migrated.Items == nil {
+		migrated.Items = map[string]any{}
+	}
+
+	if migrated.Stats.Attributes == nil {
+		migrated.Stats.Attributes = map[string]any{}
+	}
+
+	for _, migration := range ProfileMigrations {
+		if migration.Version <= userProfile.SchemaVersion || !profileMigrationApplies(migration, userProfile.ProfileId) {
+			continue
+		}
+
+		err := migration.Migrate(&migrated, userProfile.AccountId)
+		if err != nil {
+			return errors.New("migration " + migration.Name + " failed: " + err.Error())
+		}
+	}
+
+	profileData, err := json.Marshal(migrated)
+	if err != nil {
+		return err
+	}
+
+	version := CurrentProfileSchemaVersion()
+	result := all.Postgres.Model(&models.UserProfile{}).Where("id = ?", userProfile.ID).Updates(map[string]any{
+		"profile": string(profileData),
+		"schema_version": version,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	all.PrintBlue([]any{"migrated profile", userProfile.ProfileId, "for", userProfile.AccountId, "from version", userProfile.SchemaVersion, "to", version})
+
+	userProfile.Profile = string(profileData)
+	userProfile.SchemaVersion = version
+	*profile = migrated
+
+	return nil
+}
+
+func MigrateAllProfiles() (int, int) {
+	var userProfiles []models.UserProfile
+	all.Postgres.Where("schema_version < ? OR schema_version IS NULL", CurrentProfileSchemaVersion()).Find(&userProfiles)
+
+	migrated := 0
+	failed := 0
+	for _, userProfile := range userProfiles {
+		var profile models.Profile
+		err := MigrateProfile(&userProfile, &profile)
+		if err != nil {
+			all.PrintRed([]any{"could not migrate profile", userProfile.ProfileId, "for", userProfile.AccountId, err.Error()})
+			failed++
+			continue
+		}
+
+		migrated++
+	}
+
+	return migrated, failed
+}
+
+func MigrateMergeProfileTemplate(profile *models.Profile, accountId string) error {
+	template, err := ReadProfileTemplate(profile.ProfileId)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range template.Stats.Attributes {
+		if _, ok := profile.Stats.Attributes[key]; !ok {
+			profile.Stats.Attributes[key] = value
+		}
+	}
+
+	for itemId, item := range template.Items {
+		if _, ok := profile.Items[itemId]; ok {
+			continue
+		}
+
+		// cosmetics and quests may have been taken away on purpose
+		templateId := ItemTemplateId(item)
+		if strings.HasPrefix(templateId, "Athena") || strings.HasPrefix(templateId, "Quest:") {
+			continue
+		}
+
+		profile.Items[itemId] = item
+	}
+
+	return nil
+}
+
+func MigrateDefaultVariants(profile *models.Profile, accountId string) error {
+	for itemId, rawItem := range profile.Items {
+		if len(GetDefaultVariants(ItemTemplateId(rawItem))) == 0 {
+			continue
+		}
+
+		marshal, err := json.Marshal(rawItem)
+		if err != nil {
+			return err
+		}
+
+		var item models.Item
+		err = json.Unmarshal(marshal, &item)
+		if err != nil {
+			return err
+		}
+
+		for _, defaultVariant := range GetDefaultVariants(item.TemplateId) {
+			variant, err := FindVariant(&item, defaultVariant.Channel)
+			if err != nil {
+				variant = defaultVariant
+			}
+
+			SetVariantInItem(&item, variant)
+		}
+
+		profile.Items[itemId] = item
+	}
+
+	return nil
+}
+
+// profiles migrate before a request rewrites season_num, so it still holds the
+// season the player was last on when the marker is filled in
+func MigrateRolledSeason(profile *models.Profile, accountId string) error {
+	season, _ := StatToInt(profile.Stats.Attributes["season_num"])
+	if season < 1 {
+		season = Season
+	}
+
+	return all.Postgres.Model(&models.UserProfile{}).Where("account_id = ? AND profile_id = ? AND rolled_season = 0", accountId, "athena").Update("rolled_season", season).Error
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	priceTable atomic.Pointer[models.PriceTable]
+
+	ErrPriceTableMissing = errors.New("shop eligible items have no price")
+	ErrPriceTableNegative = errors.New("price table has a negative price")
+)
+
+func LoadPriceTable() (models.PriceTable, error) {
+	file, err := os.Open("data/shop/prices.json")
+	if err != nil {
+		return models.PriceTable{}, err
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return models.PriceTable{}, err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	var table models.PriceTable
+	err = json.Unmarshal([]byte(str), &table)
+	if err != nil {
+		return models.PriceTable{}, err
+	}
+
+	// overrides are matched on the lowercased template id like the rest of the profile lookups
+	items := map[string]int{}
+	for templateId, price := range table.Items {
+		items[strings.ToLower(templateId)] = price
+	}
+	table.Items = items
+
+	priceTable.Store(&table)
+	return table, nil
+}
+
+func GetPriceTable() models.PriceTable {
+	table := priceTable.Load()
+	if table != nil {
+		return *table
+	}
+
+	loaded, err := LoadPriceTable()
+	if err != nil {
+		return models.PriceTable{}
+	}
+
+	return loaded
+}
+
+// some item lists carry the series in the rarity field so both are tried
+func itemSeries(item models.BeforeStoreItem) string {
+	if item.Series != "" {
+		return item.Series
+	}
+
+	return item.Rarity
+}
+
+// an item override wins over its series, and a series wins over the type and rarity
+func GetItemPrice(item models.BeforeStoreItem) int {
+	table := GetPriceTable()
+
+	if price, ok := table.Items[strings.ToLower(item.BackendType + ":" + item.ID)]; ok {
+		return price
+	}
+
+	if price, ok := table.Series[itemSeries(item)][item.BackendType]; ok {
+		return price
+	}
+
+	return table.Types[item.BackendType][item.Rarity]
+}
+
+func HasItemPrice(item models.BeforeStoreItem) bool {
+	return GetItemPrice(item) > 0
+}
+
+// every item the rotation could pick has to resolve to a price, otherwise it would be given away
+func ValidatePriceTable() error {
+	table, err := LoadPriceTable()
+	if err != nil {
+		return err
+	}
+
+	for templateId, price := range table.Items {
+		if price < 0 {
+			return fmt.Errorf("%s: %w", templateId, ErrPriceTableNegative)
+		}
+	}
+
+	for name, prices := range table.Types {
+		for rarity, price := range prices {
+			if price < 0 {
+				return fmt.Errorf("%s %s: %w", name, rarity, ErrPriceTableNegative)
+			}
+		}
+	}
+
+	for name, prices := range table.Series {
+		for backendType, price := range prices {
+			if price < 0 {
+				return fmt.Errorf("%s %s: %w", name, backendType, ErrPriceTableNegative)
+			}
+		}
+	}
+
+	config, err := GetShopRotationConfig()
+	if err != nil {
+		return err
+	}
+
+	missing := map[string]bool{}
+	for _, item := range GetItems() {
+		if shopEligible(config, item) && !HasItemPrice(item) {
+			missing[item.BackendType + " " + item.Rarity] = true
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(missing))
+	for key := range missing {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return fmt.Errorf("%s: %w", strings.Join(keys, ", "), ErrPriceTableMissing)
+}
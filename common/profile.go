@@ -7,30 +7,38 @@ import (
 	"io"
 	"os"
 	"strconv"
-	"time"
 
-	"github.com/gin-gonic/gin"
 	"github.com/zombman/server/all"
 	"github.com/zombman/server/models"
+	"gorm.io/gorm"
 )
 
-func AddProfileToUser(user models.User, profileId string) {
+func ReadProfileTemplate(profileId string) (models.Profile, error) {
 	pathToProfile := "data/" + profileId + ".json"
 
 	file, err := os.Open(pathToProfile)
 	if err != nil {
-		return
+		return models.Profile{}, err
 	}
 	defer file.Close()
 
 	fileData, err := io.ReadAll(file)
 	if err != nil {
-		return
+		return models.Profile{}, err
 	}
 	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
 
 	unmarshaledProfile := models.Profile{}
 	err = json.Unmarshal([]byte(str), &unmarshaledProfile)
+	if err != nil {
+		return models.Profile{}, err
+	}
+
+	return unmarshaledProfile, nil
+}
+
+func AddProfileToUser(user models.User, profileId string) {
+	unmarshaledProfile, err := ReadProfileTemplate(profileId)
 	if err != nil {
 		return
 	}
@@ -38,7 +46,7 @@ func AddProfileToUser(user models.User, profileId string) {
 	if profileId == "common_core" {
 		vbucksAmount, err := strconv.Atoi(os.Getenv("USER_STARTING_VBUCKS"))
 		if err == nil { 
-			SetUserVBucks(user.AccountId, &unmarshaledProfile, vbucksAmount)
+			SetUserVBucks(user.AccountId, &unmarshaledProfile, vbucksAmount, VBucksReasonStarting, "")
 		}
 	}
 
@@ -51,17 +59,20 @@ func AddProfileToUser(user models.User, profileId string) {
 		AccountId: user.AccountId,
 		ProfileId: profileId,
 		Profile:   string(profileData),
+		SchemaVersion: CurrentProfileSchemaVersion(),
+		RolledSeason: Season,
 	})
 
 	if profileId == "athena" {
 		all.PrintBlue([]any{"creating loadouts on athena profile for", user.Username})
-		CreateLoadoutForUser(user.AccountId, "sandbox_loadout")
-		CreateLoadoutForUser(user.AccountId, "zombie_loadout")
+		CreateLoadoutForUser(user.AccountId, SandboxLoadoutId)
+		CreateLoadoutForUser(user.AccountId, LockerLoadoutId)
 
 		athenaProfile, err := ReadProfileFromUser(user.AccountId, "athena")
 		if err != nil {
 			return
 		}
+		athenaProfile.Stats.Attributes["active_loadout_index"] = 1
 
 		newAthenaProfile, err := ConvertProfileToAthena(athenaProfile)
 		if err != nil {
@@ -73,6 +84,7 @@ func AddProfileToUser(user models.User, profileId string) {
 		newAthenaProfile.Stats.Attributes.BookLevel = 1
 		newAthenaProfile.Stats.Attributes.BookXp = 0
 		newAthenaProfile.Stats.Attributes.LifetimeWins = 10
+		newAthenaProfile.Stats.Attributes.SeasonNum = Season
 
 		defaultProfile, err := ConvertAthenaToDefault(newAthenaProfile)
 		if err != nil {
@@ -103,6 +115,13 @@ func ReadProfileFromUser(accountId string, profileId string) (models.Profile, er
 	if err != nil {
 		return models.Profile{}, err
 	}
+
+	if userProfile.SchemaVersion < CurrentProfileSchemaVersion() {
+		err = MigrateProfile(&userProfile, &profileData)
+		if err != nil {
+			all.PrintRed([]any{"could not migrate profile", profileId, "for", accountId, err.Error()})
+		}
+	}
 	
 	return profileData, nil
 }
@@ -168,12 +187,16 @@ func ConvertCommonCoreToDefault(profile models.CommonCoreProfile) (models.Profil
 }
 
 func SaveProfileToUser(accountId string, profile models.Profile) error {
+	return saveProfileWith(all.Postgres, accountId, profile)
+}
+
+func saveProfileWith(db *gorm.DB, accountId string, profile models.Profile) error {
 	profileData, err := json.Marshal(profile)
 	if err != nil {
 		return err
 	}
 
-	result := all.Postgres.Model(&models.UserProfile{}).Where("account_id = ? AND profile_id = ?", accountId, profile.ProfileId).Update("profile", string(profileData))
+	result := db.Model(&models.UserProfile{}).Where("account_id = ? AND profile_id = ?", accountId, profile.ProfileId).Update("profile", string(profileData))
 	if result.Error != nil {
 		return result.Error
 	}
@@ -220,15 +243,15 @@ func CreateLoadoutForUser(accountId string, loadoutName string) {
 	all.PrintGreen([]any{"created loadout", loadoutName, "for", accountId})
 }
 
-func AppendLoadoutToProfileNoSave(profile *models.Profile, loadout *models.Loadout, accountId string) {
+func AppendLoadoutToProfileNoSave(profile *models.Profile, loadoutId string, loadout *models.Loadout, accountId string) {
 	var userLoadout models.UserLoadout
-	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name = ?", accountId, loadout.Attributes.LockerName).First(&userLoadout)
+	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name = ?", accountId, loadoutId).First(&userLoadout)
 
 	if result.Error != nil {
 		return
 	}
 
-	profile.Items[loadout.Attributes.LockerName] = *loadout
+	profile.Items[loadoutId] = *loadout
 
 	var marshaledLoadout []byte
 	marshaledLoadout, err := json.Marshal(loadout)
@@ -236,7 +259,7 @@ func AppendLoadoutToProfileNoSave(profile *models.Profile, loadout *models.Loado
 		return
 	}
 
-	result = all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name = ?", accountId, loadout.Attributes.LockerName).Update("loadout", string(marshaledLoadout))
+	result = all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name = ?", accountId, loadoutId).Update("loadout", string(marshaledLoadout))
 	if result.Error != nil {
 		return
 	}
@@ -244,7 +267,7 @@ func AppendLoadoutToProfileNoSave(profile *models.Profile, loadout *models.Loado
 
 func AppendLoadoutsToProfileNoSave(profile *models.Profile, accountId string) {
 	var loadouts []models.UserLoadout
-	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ?", accountId).Find(&loadouts)
+	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ?", accountId).Order("id").Find(&loadouts)
 
 	if result.Error != nil {
 		return
@@ -259,17 +282,26 @@ func AppendLoadoutsToProfileNoSave(profile *models.Profile, accountId string) {
 			return
 		}
 
-		loadoutIds = append(loadoutIds, loadoutData.Attributes.LockerName)
+		loadoutIds = append(loadoutIds, loadout.LoadoutName)
+		profile.Items[loadout.LoadoutName] = loadoutData
+	}
 
-		profile.Items[loadoutData.Attributes.LockerName] = loadoutData
-		profile.Stats.Attributes["loadouts"] = loadoutIds
-		profile.Stats.Attributes["active_loadout_index"] = len(loadoutIds) - 1
-		profile.Stats.Attributes["last_applied_loadout"] = loadoutData.Attributes.LockerName
+	if len(loadoutIds) == 0 {
+		return
 	}
+
+	activeLoadoutIndex, ok := StatToInt(profile.Stats.Attributes["active_loadout_index"])
+	if !ok || activeLoadoutIndex < 0 || activeLoadoutIndex >= len(loadoutIds) {
+		activeLoadoutIndex = len(loadoutIds) - 1
+	}
+
+	profile.Stats.Attributes["loadouts"] = loadoutIds
+	profile.Stats.Attributes["active_loadout_index"] = activeLoadoutIndex
+	profile.Stats.Attributes["last_applied_loadout"] = loadoutIds[activeLoadoutIndex]
 }
 
-func AppendLoadoutToProfile(profile *models.Profile, loadout *models.Loadout, accountId string) {
-	AppendLoadoutToProfileNoSave(profile, loadout, accountId)
+func AppendLoadoutToProfile(profile *models.Profile, loadoutId string, loadout *models.Loadout, accountId string) {
+	AppendLoadoutToProfileNoSave(profile, loadoutId, loadout, accountId)
 	SaveProfileToUser(accountId, *profile)
 }
 
@@ -279,25 +311,19 @@ func AppendLoadoutsToProfile(profile *models.Profile, accountId string) {
 }
 
 func GetLoadout(loadoutId string, accountId string) (models.Loadout, error) {
-	var loadouts []models.UserLoadout
-	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ?", accountId).Find(&loadouts)
+	var userLoadout models.UserLoadout
+	result := all.Postgres.Model(&models.UserLoadout{}).Where("account_id = ? AND loadout_name = ?", accountId, loadoutId).First(&userLoadout)
 	if result.Error != nil {
-		return models.Loadout{}, result.Error
+		return models.Loadout{}, errors.New("loadout not found")
 	}
 
-	for _, loadout := range loadouts {
-		var loadoutData models.Loadout
-		err := json.Unmarshal([]byte(loadout.Loadout), &loadoutData)
-		if err != nil {
-			return models.Loadout{}, err
-		}
-
-		if loadoutData.Attributes.LockerName == loadoutId {
-			return loadoutData, nil
-		}
+	var loadoutData models.Loadout
+	err := json.Unmarshal([]byte(userLoadout.Loadout), &loadoutData)
+	if err != nil {
+		return models.Loadout{}, err
 	}
 
-	return models.Loadout{}, errors.New("loadout not found")
+	return loadoutData, nil
 }
 
 func AddItemToProfile(profile *models.Profile, itemId string, accountId string) {
@@ -307,7 +333,7 @@ func AddItemToProfile(profile *models.Profile, itemId string, accountId string)
 			MaxLevelBonus: 0,
 			Level: 1,
 			ItemSeen: true,
-			Variants: []models.ItemVariant{},
+			Variants: GetDefaultVariants(itemId),
 			Favorite: false,
 			Xp: 0,
 		},
@@ -324,7 +350,7 @@ func AddItemsToProfile(profile *models.Profile, itemIds []string, accountId stri
 				MaxLevelBonus: 0,
 				Level: 1,
 				ItemSeen: true,
-				Variants: []models.ItemVariant{},
+				Variants: GetDefaultVariants(itemId),
 				Favorite: false,
 				Xp: 0,
 			},
@@ -347,62 +373,23 @@ func RemoveItemsFromProfile(profile *models.Profile, itemIds []string, accountId
 }
 
 func AddEverythingToProfile(profile *models.Profile, accountId string) {
-	pathToAllItems := "data/shop/all.json"
-
-	file, err := os.Open(pathToAllItems)
-	if err != nil {
-		return
-	}
-	defer file.Close()
-
-	fileData, err := io.ReadAll(file)
-	if err != nil {
-		return
-	}
-	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
-
-	var itemsData []models.BeforeStoreItem
-	err = json.Unmarshal([]byte(str), &itemsData)
-	if err != nil {
-		return
-	}
-
 	var itemIds []string
-	for _, item := range itemsData {
+	for _, item := range GetItems() {
 		itemIds = append(itemIds, item.BackendType + ":" + item.ID)
 	}
 
-	commonCore, err := ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{},
-			"params": gin.H{
-				"userMessage": "Enjoy this gift from the server!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
-	}
-
+	lootList := []models.LootResultItem{}
 	for _, item := range itemIds {
-		gift.Attributes["lootList"] = append(gift.Attributes["lootList"].([]gin.H), gin.H{
-			"itemType": item,
-			"itemGuid": item,
-			"itemProfile": "athena",
-			"quantity": 1,
+		lootList = append(lootList, models.LootResultItem{
+			ItemType: item,
+			ItemGuid: item,
+			ItemProfile: "athena",
+			Quantity: 1,
 		})
 	}
 
-	commonCore.Items["GiftBox:gb_default"] = gift
 	AddItemsToProfile(profile, itemIds, accountId)
-	SaveProfileToUser(accountId, commonCore)
+	SendServerGiftBox(accountId, lootList, "Enjoy this gift from the server!")
 
 	all.PrintGreen([]any{"added all items to profile", accountId})
 }
@@ -413,198 +400,54 @@ func RemoveEverythingFromProfile(profile *models.Profile, accountId string) {
 	}
 	AppendLoadoutsToProfileNoSave(profile, accountId)
 
-	commonCore, err := ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
+	lootList := []models.LootResultItem{}
+	for _, item := range []string{"AthenaCharacter:CID_001_Athena_Commando_F_Default", "AthenaPickaxe:DefaultPickaxe", "AthenaGlider:DefaultGlider", "AthenaDance:EID_DanceMoves"} {
+		lootList = append(lootList, models.LootResultItem{
+			ItemType: item,
+			ItemGuid: item,
+			ItemProfile: "athena",
+			Quantity: 1,
+		})
 	}
 
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				{
-					"itemType": "AthenaCharacter:CID_001_Athena_Commando_F_Default",
-					"itemGuid": "AthenaCharacter:CID_001_Athena_Commando_F_Default",
-					"itemProfile": "athena",
-					"quantity": 1,
-				},
-				{
-					"itemType": "AthenaPickaxe:DefaultPickaxe",
-					"itemGuid": "AthenaPickaxe:DefaultPickaxe",
-					"itemProfile": "athena",
-					"quantity": 1,
-				},
-				{
-					"itemType": "AthenaGlider:DefaultGlider",
-					"itemGuid": "AthenaGlider:DefaultGlider",
-					"itemProfile": "athena",
-					"quantity": 1,
-				},
-				{
-					"itemType": "AthenaDance:EID_DanceMoves",
-					"itemGuid": "AthenaDance:EID_DanceMoves",
-					"itemProfile": "athena",
-					"quantity": 1,
-				},
-			},
-			"params": gin.H{
-				"userMessage": "Server has removed all items from your account. Enjoy!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
-	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	SaveProfileToUser(accountId, commonCore)
+	SendServerGiftBox(accountId, lootList, "Server has removed all items from your account. Enjoy!")
 }
 
-func SetUserVBucks(accountId string, profile *models.Profile, amount int) {
-	_, err := GetUserByAccountId(accountId)
-	if err != nil {
-		return
-	}
-
-	wantedAmount := amount
-
-	all.Postgres.Model(&models.User{}).Where("account_id = ?", accountId).Update("v_bucks", wantedAmount)
-	
-	profile.Items["Currency:MtxPurchased"] = models.CommonCoreItem{
-		TemplateId: "Currency:MtxPurchased",
-		Attributes: map[string]any {
-			"platform": "EpicPC",
-		},
-		Quantity: wantedAmount,
-	}
-
-	commonCore, err := ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				{
-					"itemType": "MtxCurrency:MTXCurrency",
-					"itemGuid": "MtxCurrency:MTXCurrency",
-					"itemProfile": "athena",
-					"quantity": wantedAmount,
-				},
-			},
-			"params": gin.H{
-				"userMessage": "Server has updated your account. Enjoy!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
+func SetUserVBucks(accountId string, profile *models.Profile, amount int, reason string, reference string) (int, error) {
+	balance := GetVBucksBalance(accountId)
+	if amount != balance {
+		var err error
+		balance, err = RecordVBucksTransaction(accountId, amount - balance, reason, reference)
+		if err != nil {
+			return 0, err
+		}
 	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	SaveProfileToUser(accountId, commonCore)
-
+	SetVBucksItem(profile, balance)
 	AppendLoadoutsToProfileNoSave(profile, accountId)
-}
-
-func TakeUserVBucks(accountId string, profile *models.Profile, amount int) {
-	user, err := GetUserByAccountId(accountId)
-	if err != nil {
-		return
-	}
-
-	wantedAmount := user.VBucks - amount
-
-	all.Postgres.Model(&models.User{}).Where("account_id = ?", accountId).Update("v_bucks", wantedAmount)
-	
-	profile.Items["Currency:MtxPurchased"] = models.CommonCoreItem{
-		TemplateId: "Currency:MtxPurchased",
-		Attributes: map[string]any {
-			"platform": "EpicPC",
-		},
-		Quantity: wantedAmount,
-	}
-
-	commonCore, err := ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				{
-					"itemType": "MtxCurrency:MTXCurrency",
-					"itemGuid": "MtxCurrency:MTXCurrency",
-					"itemProfile": "athena",
-					"quantity": wantedAmount,
-				},
-			},
-			"params": gin.H{
-				"userMessage": "Server has updated your account. Enjoy!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
-	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	SaveProfileToUser(accountId, commonCore)
 
-	AppendLoadoutsToProfileNoSave(profile, accountId)
+	return balance, nil
 }
 
-func AddUserVBucks(accountId string, profile *models.Profile, amount int) {
-	user, err := GetUserByAccountId(accountId)
+func TakeUserVBucks(accountId string, profile *models.Profile, amount int, reason string, reference string) (int, error) {
+	balance, err := RecordVBucksTransaction(accountId, -amount, reason, reference)
 	if err != nil {
-		return
+		return 0, err
 	}
+	SetVBucksItem(profile, balance)
+	AppendLoadoutsToProfileNoSave(profile, accountId)
 
-	wantedAmount := user.VBucks + amount
+	return balance, nil
+}
 
-	all.Postgres.Model(&models.User{}).Where("account_id = ?", accountId).Update("v_bucks", wantedAmount)
-	
-	profile.Items["Currency:MtxPurchased"] = models.CommonCoreItem{
-		TemplateId: "Currency:MtxPurchased",
-		Attributes: map[string]any {
-			"platform": "EpicPC",
-		},
-		Quantity: wantedAmount,
-	}
-	
-	commonCore, err := ReadProfileFromUser(accountId, "common_core")
+func AddUserVBucks(accountId string, profile *models.Profile, amount int, reason string, reference string) (int, error) {
+	balance, err := RecordVBucksTransaction(accountId, amount, reason, reference)
 	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				{
-					"itemType": "MtxCurrency:MTXCurrency",
-					"itemGuid": "MtxCurrency:MTXCurrency",
-					"itemProfile": "athena",
-					"quantity": wantedAmount,
-				},
-			},
-			"params": gin.H{
-				"userMessage": "Server has updated your account. Enjoy!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
+		return 0, err
 	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	SaveProfileToUser(accountId, commonCore)
-
+	SetVBucksItem(profile, balance)
 	AppendLoadoutsToProfileNoSave(profile, accountId)
+
+	return balance, nil
 }
 
 func GetItemFromProfile(profile *models.Profile, itemId string) (models.Item, error) {
@@ -638,15 +481,19 @@ func FindVariant(item *models.Item, channel string) (models.ItemVariant, error)
 }
 
 func SetVariantInItem(item *models.Item, variant models.ItemVariant) (models.ItemVariant, error) {
+	owned := appendMissingTags(GetOwnedVariantTags(item, variant.Channel), variant.Owned)
+	owned = appendMissingTags(owned, []string{variant.Active})
+
 	foundVariant, err := FindVariant(item, variant.Channel)
 	if err != nil {
+		variant.Owned = owned
 		item.Attributes.Variants = append(item.Attributes.Variants, variant)
 		return variant, nil
 	}
 
 	foundVariant.Active = variant.Active
 	foundVariant.Channel = variant.Channel
-	foundVariant.Owned = []string{variant.Active}
+	foundVariant.Owned = owned
 
 	for i, v := range item.Attributes.Variants {
 		if v.Channel == variant.Channel {
@@ -663,17 +510,20 @@ func GetFullAthenaProfile(accountId string) models.Profile {
 		return models.Profile{}
 	}
 
-	loadout, err := GetLoadout("zombie_loadout", accountId)
-	if err != nil {
-		return models.Profile{}
-	}
-	profile.Items["zombie_loadout"] = loadout
-
-	loadout2, err := GetLoadout("sandbox_loadout", accountId)
-	if err != nil {
-		return models.Profile{}
-	}
-	profile.Items["sandbox_loadout"] = loadout2
+	AppendLoadoutsToProfileNoSave(&profile, accountId)
 
 	return profile
-}
\ No newline at end of file
+}
+
+func StatToInt(value any) (int, bool) {
+	switch v := value.(type) {
+		case int:
+			return v, true
+		case float64:
+			return int(v), true
+		case float32:
+			return int(v), true
+		default:
+			return 0, false
+	}
+}
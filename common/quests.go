@@ -0,0 +1,635 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var (
+	AllSeasonQuests = make(map[int]models.SeasonQuests)
+	seasonQuestsMutex sync.RWMutex
+)
+
+func GetSeasonQuests(season int) (models.SeasonQuests, error) {
+	seasonQuestsMutex.RLock()
+	quests, ok := AllSeasonQuests[season]
+	seasonQuestsMutex.RUnlock()
+	if ok {
+		return quests, nil
+	}
+
+	file, err := os.Open("data/quests/" + strconv.Itoa(season) + ".json")
+	if err != nil {
+		return models.SeasonQuests{}, err
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return models.SeasonQuests{}, err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	err = json.Unmarshal([]byte(str), &quests)
+	if err != nil {
+		return models.SeasonQuests{}, err
+	}
+
+	seasonQuestsMutex.Lock()
+	AllSeasonQuests[season] = quests
+	seasonQuestsMutex.Unlock()
+
+	return quests, nil
+}
+
+func FindQuestDefinition(quests models.SeasonQuests, templateId string) (models.QuestDefinition, bool) {
+	for _, quest := range quests.Daily {
+		if strings.EqualFold(quest.TemplateId, templateId) {
+			return quest, true
+		}
+	}
+
+	for _, schedule := range quests.Schedules {
+		for _, bundle := range schedule.Bundles {
+			for _, quest := range bundle.Quests {
+				if strings.EqualFold(quest.TemplateId, templateId) {
+					return quest, true
+				}
+			}
+		}
+	}
+
+	return models.QuestDefinition{}, false
+}
+
+func IsDailyQuest(quests models.SeasonQuests, templateId string) bool {
+	for _, quest := range quests.Daily {
+		if strings.EqualFold(quest.TemplateId, templateId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SEASON_START wins over the season file, and without either the season starts with the server
+func GetQuestSeasonStart(quests models.SeasonQuests) time.Time {
+	if SeasonStart != "" {
+		if seasonStart, err := time.Parse("2006-01-02", SeasonStart); err == nil {
+			return seasonStart
+		}
+	}
+
+	if seasonStart, err := time.Parse("2006-01-02T15:04:05.999Z", quests.SeasonStart); err == nil {
+		return seasonStart
+	}
+
+	return ServerStarted
+}
+
+// weeks past the last defined one stay on it instead of counting on forever
+func GetQuestWeek(quests models.SeasonQuests) int {
+	week := int(time.Since(GetQuestSeasonStart(quests)).Hours() / 24 / 7) + 1
+	if week < 1 {
+		week = 1
+	}
+
+	lastWeek := 0
+	for _, schedule := range quests.Schedules {
+		for _, bundle := range schedule.Bundles {
+			if bundle.UnlockWeek > lastWeek {
+				lastWeek = bundle.UnlockWeek
+			}
+		}
+	}
+
+	if lastWeek > 0 && week > lastWeek {
+		return lastWeek
+	}
+
+	return week
+}
+
+func ToCommonCoreItem(item any) (models.CommonCoreItem, error) {
+	marshal, err := json.Marshal(item)
+	if err != nil {
+		return models.CommonCoreItem{}, err
+	}
+
+	var commonCoreItem models.CommonCoreItem
+	err = json.Unmarshal(marshal, &commonCoreItem)
+	if err != nil {
+		return models.CommonCoreItem{}, err
+	}
+
+	if commonCoreItem.Attributes == nil {
+		commonCoreItem.Attributes = map[string]any{}
+	}
+
+	return commonCoreItem, nil
+}
+
+func addQuestToProfile(profile *models.Profile, definition models.QuestDefinition, bundleId string) (string, models.CommonCoreItem) {
+	now := time.Now().Format("2006-01-02T15:04:05.999Z")
+	quest := models.CommonCoreItem{
+		TemplateId: definition.TemplateId,
+		Attributes: map[string]any{
+			"creation_time": now,
+			"quest_state": "Active",
+			"last_state_change_time": now,
+			"level": -1,
+			"item_seen": false,
+			"sent_new_notification": false,
+			"challenge_bundle_id": bundleId,
+			"xp_reward_scalar": 1,
+			"quest_rarity": "uncommon",
+			"max_level_bonus": 0,
+			"xp": 0,
+			"favorite": false,
+		},
+		Quantity: 1,
+	}
+
+	for _, objective := range definition.Objectives {
+		quest.Attributes["completion_" + objective.Name] = 0
+	}
+
+	questId := uuid.New().String()
+	profile.Items[questId] = quest
+
+	return questId, quest
+}
+
+func getQuestManager(profile *models.Profile) map[string]any {
+	manager, ok := profile.Stats.Attributes["quest_manager"].(map[string]any)
+	if !ok {
+		manager = map[string]any{}
+		profile.Stats.Attributes["quest_manager"] = manager
+	}
+
+	return manager
+}
+
+func getActiveDailyQuests(profile *models.Profile, quests models.SeasonQuests) map[string]string {
+	active := map[string]string{}
+	for itemId, item := range profile.Items {
+		templateId := ItemTemplateId(item)
+		if !IsDailyQuest(quests, templateId) {
+			continue
+		}
+
+		quest, err := ToCommonCoreItem(item)
+		if err != nil || quest.Attributes["quest_state"] != "Active" {
+			continue
+		}
+
+		active[strings.ToLower(templateId)] = itemId
+	}
+
+	return active
+}
+
+func pickDailyQuest(quests models.SeasonQuests, exclude map[string]string) (models.QuestDefinition, bool) {
+	choices := []models.QuestDefinition{}
+	for _, quest := range quests.Daily {
+		if _, ok := exclude[strings.ToLower(quest.TemplateId)]; ok {
+			continue
+		}
+
+		choices = append(choices, quest)
+	}
+
+	if len(choices) == 0 {
+		return models.QuestDefinition{}, false
+	}
+
+	return choices[rand.Intn(len(choices))], true
+}
+
+func AssignDailyQuests(profile *models.Profile, quests models.SeasonQuests) []models.ProfileChange {
+	changes := []models.ProfileChange{}
+	manager := getQuestManager(profile)
+
+	lastLogin, _ := manager["dailyLoginInterval"].(string)
+	if strings.HasPrefix(lastLogin, time.Now().Format("2006-01-02")) {
+		return changes
+	}
+
+	manager["dailyLoginInterval"] = time.Now().Format("2006-01-02T15:04:05.999Z")
+	manager["dailyQuestRerolls"] = quests.DailyRerolls
+
+	changes = append(changes, pruneClaimedDailyQuests(profile, quests)...)
+
+	active := getActiveDailyQuests(profile, quests)
+	for len(active) < quests.MaxDailyQuests {
+		definition, ok := pickDailyQuest(quests, active)
+		if !ok {
+			break
+		}
+
+		questId, quest := addQuestToProfile(profile, definition, "")
+		active[strings.ToLower(definition.TemplateId)] = questId
+
+		changes = append(changes, models.ProfileChange{
+			ChangeType: "itemAdded",
+			ItemID: questId,
+			Item: quest,
+		})
+	}
+
+	changes = append(changes, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "quest_manager",
+		Value: manager,
+	})
+
+	return changes
+}
+
+// finished dailies would otherwise pile up in the profile forever
+func pruneClaimedDailyQuests(profile *models.Profile, quests models.SeasonQuests) []models.ProfileChange {
+	changes := []models.ProfileChange{}
+	for itemId, item := range profile.Items {
+		if !IsDailyQuest(quests, ItemTemplateId(item)) {
+			continue
+		}
+
+		quest, err := ToCommonCoreItem(item)
+		if err != nil || quest.Attributes["quest_state"] != "Claimed" {
+			continue
+		}
+
+		delete(profile.Items, itemId)
+		changes = append(changes, models.ProfileChange{
+			ChangeType: "itemRemoved",
+			ItemID: itemId,
+		})
+	}
+
+	return changes
+}
+
+func UnlockWeeklyQuests(profile *models.Profile, quests models.SeasonQuests) []models.ProfileChange {
+	changes := []models.ProfileChange{}
+	week := GetQuestWeek(quests)
+	bookPurchased, _ := profile.Stats.Attributes["book_purchased"].(bool)
+
+	for _, schedule := range quests.Schedules {
+		if schedule.RequiresBattlePass && !bookPurchased {
+			continue
+		}
+
+		scheduleItem, err := ToCommonCoreItem(profile.Items[schedule.TemplateId])
+		isNewSchedule := err != nil || scheduleItem.TemplateId == ""
+		if isNewSchedule {
+			scheduleItem = models.CommonCoreItem{
+				TemplateId: schedule.TemplateId,
+				Attributes: map[string]any{
+					"unlock_epoch": GetQuestSeasonStart(quests).Format("2006-01-02T15:04:05.999Z"),
+					"max_level_bonus": 0,
+					"level": 0,
+					"item_seen": true,
+					"xp": 0,
+					"favorite": false,
+					"granted_bundles": []string{},
+				},
+				Quantity: 1,
+			}
+		}
+
+		grantedBundles := []string{}
+		if granted, ok := scheduleItem.Attributes["granted_bundles"].([]any); ok {
+			for _, bundleId := range granted {
+				if id, ok := bundleId.(string); ok {
+					grantedBundles = append(grantedBundles, id)
+				}
+			}
+		}
+
+		newBundles := false
+		for _, bundle := range schedule.Bundles {
+			if bundle.UnlockWeek > week {
+				continue
+			}
+
+			if _, ok := profile.Items[bundle.TemplateId]; ok {
+				continue
+			}
+
+			questIds := []string{}
+			for _, definition := range bundle.Quests {
+				questId, quest := addQuestToProfile(profile, definition, bundle.TemplateId)
+				questIds = append(questIds, questId)
+
+				changes = append(changes, models.ProfileChange{
+					ChangeType: "itemAdded",
+					ItemID: questId,
+					Item: quest,
+				})
+			}
+
+			bundleItem := models.CommonCoreItem{
+				TemplateId: bundle.TemplateId,
+				Attributes: map[string]any{
+					"has_unlock_by_completion": false,
+					"num_quests_completed": 0,
+					"level": 0,
+					"grantedquestinstanceids": questIds,
+					"item_seen": false,
+					"max_allowed_bundle_level": 0,
+					"num_granted_bundle_quests": len(questIds),
+					"max_level_bonus": 0,
+					"challenge_bundle_schedule_id": schedule.TemplateId,
+					"num_progress_quests_completed": 0,
+					"xp": 0,
+					"favorite": false,
+				},
+				Quantity: 1,
+			}
+			profile.Items[bundle.TemplateId] = bundleItem
+			grantedBundles = append(grantedBundles, bundle.TemplateId)
+			newBundles = true
+
+			changes = append(changes, models.ProfileChange{
+				ChangeType: "itemAdded",
+				ItemID: bundle.TemplateId,
+				Item: bundleItem,
+			})
+		}
+
+		if !newBundles && !isNewSchedule {
+			continue
+		}
+
+		scheduleItem.Attributes["granted_bundles"] = grantedBundles
+		profile.Items[schedule.TemplateId] = scheduleItem
+
+		if isNewSchedule {
+			changes = append(changes, models.ProfileChange{
+				ChangeType: "itemAdded",
+				ItemID: schedule.TemplateId,
+				Item: scheduleItem,
+			})
+		} else {
+			changes = append(changes, models.ProfileChange{
+				ChangeType: "itemAttrChanged",
+				ItemID: schedule.TemplateId,
+				AttributeName: "granted_bundles",
+				AttributeValue: grantedBundles,
+			})
+		}
+	}
+
+	return changes
+}
+
+func RefreshQuests(profile *models.Profile) []models.ProfileChange {
+	quests, err := GetSeasonQuests(Season)
+	if err != nil {
+		return []models.ProfileChange{}
+	}
+
+	changes := AssignDailyQuests(profile, quests)
+	changes = append(changes, UnlockWeeklyQuests(profile, quests)...)
+
+	return changes
+}
+
+func RerollDailyQuest(profile *models.Profile, questId string) (string, []models.ProfileChange, error) {
+	quests, err := GetSeasonQuests(Season)
+	if err != nil {
+		return "", nil, err
+	}
+
+	item, ok := profile.Items[questId]
+	if !ok || !IsDailyQuest(quests, ItemTemplateId(item)) {
+		return "", nil, errors.New("daily quest not found")
+	}
+
+	manager := getQuestManager(profile)
+	rerolls, _ := StatToInt(manager["dailyQuestRerolls"])
+	if rerolls < 1 {
+		return "", nil, errors.New("no daily quest rerolls left")
+	}
+
+	// never hand back the quest that was just rerolled
+	exclude := getActiveDailyQuests(profile, quests)
+	exclude[strings.ToLower(ItemTemplateId(item))] = questId
+
+	definition, ok := pickDailyQuest(quests, exclude)
+	if !ok {
+		return "", nil, errors.New("no daily quests left to reroll into")
+	}
+
+	delete(profile.Items, questId)
+	newQuestId, quest := addQuestToProfile(profile, definition, "")
+	manager["dailyQuestRerolls"] = rerolls - 1
+
+	return newQuestId, []models.ProfileChange{
+		{
+			ChangeType: "itemRemoved",
+			ItemID: questId,
+		},
+		{
+			ChangeType: "itemAdded",
+			ItemID: newQuestId,
+			Item: quest,
+		},
+		{
+			ChangeType: "statModified",
+			Name: "quest_manager",
+			Value: manager,
+		},
+	}, nil
+}
+
+func MarkQuestNotificationsSent(profile *models.Profile, itemIds []string) []models.ProfileChange {
+	changes := []models.ProfileChange{}
+
+	for _, itemId := range itemIds {
+		item, ok := profile.Items[itemId]
+		if !ok || !strings.HasPrefix(ItemTemplateId(item), "Quest:") {
+			continue
+		}
+
+		quest, err := ToCommonCoreItem(item)
+		if err != nil {
+			continue
+		}
+
+		quest.Attributes["sent_new_notification"] = true
+		profile.Items[itemId] = quest
+
+		changes = append(changes, models.ProfileChange{
+			ChangeType: "itemAttrChanged",
+			ItemID: itemId,
+			AttributeName: "sent_new_notification",
+			AttributeValue: true,
+		})
+	}
+
+	return changes
+}
+
+func UpdateQuestProgress(athenaProfile *models.Profile, commonCore *models.Profile, accountId string, objectives map[string]int) models.BattlePassGrantResult {
+	result := models.BattlePassGrantResult{
+		LootItems: []models.LootResultItem{},
+		AthenaChanges: []models.ProfileChange{},
+		CommonCoreChanges: []models.ProfileChange{},
+	}
+
+	quests, err := GetSeasonQuests(Season)
+	if err != nil {
+		return result
+	}
+
+	progress := map[string]int{}
+	for name, count := range objectives {
+		progress[strings.ToLower(name)] += count
+	}
+
+	rewards := []models.BattlePassReward{}
+	xp := 0
+	now := time.Now().Format("2006-01-02T15:04:05.999Z")
+
+	for itemId, item := range athenaProfile.Items {
+		if !strings.HasPrefix(ItemTemplateId(item), "Quest:") {
+			continue
+		}
+
+		quest, err := ToCommonCoreItem(item)
+		if err != nil || quest.Attributes["quest_state"] != "Active" {
+			continue
+		}
+
+		definition, ok := FindQuestDefinition(quests, quest.TemplateId)
+		if !ok {
+			continue
+		}
+
+		changed := false
+		completed := true
+		for _, objective := range definition.Objectives {
+			attributeName := "completion_" + objective.Name
+			current, _ := StatToInt(quest.Attributes[attributeName])
+
+			if increment, ok := progress[strings.ToLower(objective.Name)]; ok && current < objective.Count {
+				current += increment
+				if current > objective.Count {
+					current = objective.Count
+				}
+
+				quest.Attributes[attributeName] = current
+				changed = true
+
+				result.AthenaChanges = append(result.AthenaChanges, models.ProfileChange{
+					ChangeType: "itemAttrChanged",
+					ItemID: itemId,
+					AttributeName: attributeName,
+					AttributeValue: current,
+				})
+			}
+
+			if current < objective.Count {
+				completed = false
+			}
+		}
+
+		if !changed {
+			continue
+		}
+
+		if completed {
+			quest.Attributes["quest_state"] = "Claimed"
+			quest.Attributes["last_state_change_time"] = now
+			result.AthenaChanges = append(result.AthenaChanges, models.ProfileChange{
+				ChangeType: "itemAttrChanged",
+				ItemID: itemId,
+				AttributeName: "quest_state",
+				AttributeValue: "Claimed",
+			})
+
+			rewards = appendTierRewards(rewards, definition.Rewards, 0, false)
+			xp += definition.Xp
+
+			if bundleId, _ := quest.Attributes["challenge_bundle_id"].(string); bundleId != "" {
+				result.AthenaChanges = append(result.AthenaChanges, completeBundleQuest(athenaProfile, bundleId)...)
+			}
+
+			all.PrintGreen([]any{accountId, "completed quest", quest.TemplateId})
+		}
+
+		athenaProfile.Items[itemId] = quest
+	}
+
+	if len(rewards) > 0 {
+		granted := GrantBattlePassRewards(athenaProfile, commonCore, rewards, accountId)
+		result = mergeGrantResults(result, granted)
+	}
+
+	if xp > 0 {
+		granted := AddXpToProfile(athenaProfile, commonCore, accountId, xp)
+		result = mergeGrantResults(result, granted)
+	}
+
+	return result
+}
+
+func completeBundleQuest(profile *models.Profile, bundleId string) []models.ProfileChange {
+	bundle, err := ToCommonCoreItem(profile.Items[bundleId])
+	if err != nil || bundle.TemplateId == "" {
+		return []models.ProfileChange{}
+	}
+
+	completed, _ := StatToInt(bundle.Attributes["num_quests_completed"])
+	bundle.Attributes["num_quests_completed"] = completed + 1
+	bundle.Attributes["num_progress_quests_completed"] = completed + 1
+	profile.Items[bundleId] = bundle
+
+	return []models.ProfileChange{
+		{
+			ChangeType: "itemAttrChanged",
+			ItemID: bundleId,
+			AttributeName: "num_quests_completed",
+			AttributeValue: completed + 1,
+		},
+		{
+			ChangeType: "itemAttrChanged",
+			ItemID: bundleId,
+			AttributeName: "num_progress_quests_completed",
+			AttributeValue: completed + 1,
+		},
+	}
+}
+
+func mergeGrantResults(result models.BattlePassGrantResult, other models.BattlePassGrantResult) models.BattlePassGrantResult {
+	result.LootItems = append(result.LootItems, other.LootItems...)
+	result.AthenaChanges = append(result.AthenaChanges, other.AthenaChanges...)
+	result.CommonCoreChanges = append(result.CommonCoreChanges, other.CommonCoreChanges...)
+	result.VBucks += other.VBucks
+
+	return result
+}
+
+func RemoveSeasonQuests(profile *models.Profile) {
+	for itemId, item := range profile.Items {
+		templateId := ItemTemplateId(item)
+		if strings.HasPrefix(templateId, "Quest:") || strings.HasPrefix(templateId, "ChallengeBundle:") || strings.HasPrefix(templateId, "ChallengeBundleSchedule:") {
+			delete(profile.Items, itemId)
+		}
+	}
+
+	profile.Stats.Attributes["quest_manager"] = map[string]any{}
+}
@@ -0,0 +1,251 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+	"gorm.io/gorm"
+)
+
+var (
+	MaxRefundCredits int = 3
+	RefundTokenRefreshPeriod = time.Hour * 24 * 365
+	RefundUndoPeriod = time.Hour * 24
+
+	ErrPurchaseNotFound = errors.New("purchase not found")
+	ErrPurchaseAlreadyRefunded = errors.New("purchase already refunded")
+	ErrPurchaseNotRefundable = errors.New("purchase is not refundable")
+	ErrNoRefundCredits = errors.New("no refund credits left")
+)
+
+// battle pass and tier purchases level the book, boost xp and grant v-bucks
+// through the ledger, a refund can't take any of that back
+func IsBattlePassOffer(offerId string) bool {
+	return strings.Contains(offerId, "battlepass")
+}
+
+func GetMtxPurchaseHistory(profile *models.Profile) models.MtxPurchaseHistory {
+	history := models.MtxPurchaseHistory{
+		RefundCredits: MaxRefundCredits,
+	}
+
+	if raw, ok := profile.Stats.Attributes["mtx_purchase_history"]; ok {
+		marshal, err := json.Marshal(raw)
+		if err == nil {
+			json.Unmarshal(marshal, &history)
+		}
+	}
+
+	if history.Purchases == nil {
+		history.Purchases = []models.MtxPurchase{}
+	}
+
+	return history
+}
+
+func SetMtxPurchaseHistory(profile *models.Profile, history models.MtxPurchaseHistory) {
+	profile.Stats.Attributes["mtx_purchase_history"] = history
+}
+
+func RecordMtxPurchase(accountId string, commonCore *models.Profile, offer models.CatalogEntry, price int, quantity int, lootItems []models.LootResultItem) models.MtxPurchase {
+	return recordMtxPurchaseWith(all.Postgres, accountId, commonCore, offer, price, quantity, lootItems, map[string]any{})
+}
+
+func recordMtxPurchaseWith(db *gorm.DB, accountId string, commonCore *models.Profile, offer models.CatalogEntry, price int, quantity int, lootItems []models.LootResultItem, metadata map[string]any) models.MtxPurchase {
+	now := time.Now()
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	fulfillments := offer.FulfillmentIDs
+	if fulfillments == nil {
+		fulfillments = []string{}
+	}
+
+	purchase := models.MtxPurchase{
+		PurchaseId: uuid.New().String(),
+		OfferId: offer.OfferID,
+		PurchaseDate: now.Format("2006-01-02T15:04:05.999Z"),
+		UndoTimeout: now.Add(RefundUndoPeriod).Format("2006-01-02T15:04:05.999Z"),
+		FreeRefundEligible: offer.Refundable && !IsBattlePassOffer(offer.OfferID),
+		Refundable: offer.Refundable && !IsBattlePassOffer(offer.OfferID),
+		Quantity: quantity,
+		Fulfillments: fulfillments,
+		LootResult: lootItems,
+		TotalMtxPaid: price,
+		Metadata: metadata,
+		GameContext: "",
+	}
+
+	recordCreatorPurchase(db, accountId, commonCore, &purchase)
+
+	history := GetMtxPurchaseHistory(commonCore)
+	history.Purchases = append(history.Purchases, purchase)
+	SetMtxPurchaseHistory(commonCore, history)
+
+	return purchase
+}
+
+func IsGiftPurchase(purchase models.MtxPurchase) bool {
+	gift, _ := purchase.Metadata["gift"].(bool)
+	return gift
+}
+
+// every credit comes back once a year has passed since the last refill
+func RefreshRefundCredits(history *models.MtxPurchaseHistory) bool {
+	now := time.Now()
+
+	reference, err := time.Parse("2006-01-02T15:04:05.999Z", history.TokenRefreshReferenceTime)
+	if err != nil {
+		history.TokenRefreshReferenceTime = now.Format("2006-01-02T15:04:05.999Z")
+		return true
+	}
+
+	if now.Before(reference.Add(RefundTokenRefreshPeriod)) {
+		return false
+	}
+
+	for !now.Before(reference.Add(RefundTokenRefreshPeriod)) {
+		reference = reference.Add(RefundTokenRefreshPeriod)
+	}
+
+	history.RefundCredits = MaxRefundCredits
+	history.TokenRefreshReferenceTime = reference.Format("2006-01-02T15:04:05.999Z")
+
+	return true
+}
+
+func findMtxPurchase(history *models.MtxPurchaseHistory, purchaseId string) (*models.MtxPurchase, error) {
+	for i := range history.Purchases {
+		if history.Purchases[i].PurchaseId == purchaseId {
+			return &history.Purchases[i], nil
+		}
+	}
+
+	return nil, ErrPurchaseNotFound
+}
+
+func purchaseInUndoPeriod(purchase *models.MtxPurchase) bool {
+	if !purchase.FreeRefundEligible {
+		return false
+	}
+
+	undoTimeout, err := time.Parse("2006-01-02T15:04:05.999Z", purchase.UndoTimeout)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().Before(undoTimeout)
+}
+
+func removeRefundedItem(profile *models.Profile, loot models.LootResultItem) (models.ProfileChange, bool) {
+	rawItem, ok := profile.Items[loot.ItemGuid]
+	if !ok {
+		return models.ProfileChange{}, false
+	}
+
+	marshal, err := json.Marshal(rawItem)
+	if err != nil {
+		return models.ProfileChange{}, false
+	}
+
+	var item models.Item
+	err = json.Unmarshal(marshal, &item)
+	if err != nil {
+		return models.ProfileChange{}, false
+	}
+
+	if !strings.HasPrefix(item.TemplateId, "Athena") && item.Quantity > loot.Quantity {
+		item.Quantity -= loot.Quantity
+		profile.Items[loot.ItemGuid] = item
+
+		return models.ProfileChange{
+			ChangeType: "itemQuantityChanged",
+			ItemID: loot.ItemGuid,
+			Quantity: item.Quantity,
+		}, true
+	}
+
+	delete(profile.Items, loot.ItemGuid)
+
+	return models.ProfileChange{
+		ChangeType: "itemRemoved",
+		ItemID: loot.ItemGuid,
+	}, true
+}
+
+// admins can refund anything but battle pass and gift purchases without spending the player's credits
+func RefundMtxPurchase(accountId string, commonCore *models.Profile, athenaProfile *models.Profile, purchaseId string, useCredits bool) (models.MtxPurchaseRefund, error) {
+	history := GetMtxPurchaseHistory(commonCore)
+	RefreshRefundCredits(&history)
+
+	purchase, err := findMtxPurchase(&history, purchaseId)
+	if err != nil {
+		return models.MtxPurchaseRefund{}, err
+	}
+
+	if purchase.RefundDate != "" {
+		return models.MtxPurchaseRefund{}, ErrPurchaseAlreadyRefunded
+	}
+
+	// a gift's items are in someone else's profile, so there is nothing here to take back
+	if IsBattlePassOffer(purchase.OfferId) || IsGiftPurchase(*purchase) {
+		return models.MtxPurchaseRefund{}, ErrPurchaseNotRefundable
+	}
+
+	if useCredits && !purchase.Refundable {
+		return models.MtxPurchaseRefund{}, ErrPurchaseNotRefundable
+	}
+
+	creditUsed := useCredits && !purchaseInUndoPeriod(purchase)
+	if creditUsed && history.RefundCredits < 1 {
+		return models.MtxPurchaseRefund{}, ErrNoRefundCredits
+	}
+
+	balance, err := AddUserVBucks(accountId, commonCore, purchase.TotalMtxPaid, VBucksReasonRefund, purchase.PurchaseId)
+	if err != nil {
+		return models.MtxPurchaseRefund{}, err
+	}
+
+	result := models.MtxPurchaseRefund{
+		AthenaChanges: []models.ProfileChange{},
+		CommonCoreChanges: []models.ProfileChange{},
+		VBucks: balance,
+	}
+
+	for _, loot := range purchase.LootResult {
+		if loot.ItemProfile == "common_core" {
+			if change, ok := removeRefundedItem(commonCore, loot); ok {
+				result.CommonCoreChanges = append(result.CommonCoreChanges, change)
+			}
+			continue
+		}
+
+		if change, ok := removeRefundedItem(athenaProfile, loot); ok {
+			result.AthenaChanges = append(result.AthenaChanges, change)
+		}
+	}
+	AppendLoadoutsToProfileNoSave(athenaProfile, accountId)
+
+	if creditUsed {
+		history.RefundCredits -= 1
+	}
+	history.RefundsUsed += 1
+	purchase.RefundDate = time.Now().Format("2006-01-02T15:04:05.999Z")
+	result.Purchase = *purchase
+	refundCreatorPurchase(purchase.PurchaseId)
+
+	SetMtxPurchaseHistory(commonCore, history)
+	result.CommonCoreChanges = append(result.CommonCoreChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "mtx_purchase_history",
+		Value: history,
+	})
+
+	return result, nil
+}
@@ -0,0 +1,193 @@
+package common
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	ErrRequirementOwnsItem = errors.New("already owns a denied item")
+	ErrRequirementFulfilled = errors.New("already has a denied fulfillment")
+	ErrRequirementNotFulfilled = errors.New("missing a required fulfillment")
+	ErrRequirementUnknown = errors.New("unknown requirement type")
+	ErrDailyLimitReached = errors.New("daily purchase limit reached")
+	ErrWeeklyLimitReached = errors.New("weekly purchase limit reached")
+	ErrMonthlyLimitReached = errors.New("monthly purchase limit reached")
+)
+
+func itemQuantity(item any) int {
+	quantity := 0
+	switch v := item.(type) {
+		case models.Item:
+			quantity = v.Quantity
+		case models.CommonCoreItem:
+			quantity = v.Quantity
+		case map[string]any:
+			quantity, _ = StatToInt(v["quantity"])
+	}
+
+	if quantity < 1 {
+		return 1
+	}
+
+	return quantity
+}
+
+func ownedTemplateIds(profiles ...*models.Profile) map[string]int {
+	owned := map[string]int{}
+	for _, profile := range profiles {
+		if profile == nil {
+			continue
+		}
+
+		for _, item := range profile.Items {
+			templateId := strings.ToLower(ItemTemplateId(item))
+			if templateId == "" {
+				continue
+			}
+
+			owned[templateId] += itemQuantity(item)
+		}
+	}
+
+	return owned
+}
+
+func ownedFulfillments(commonCore *models.Profile) map[string]int {
+	owned := map[string]int{}
+	if commonCore == nil {
+		return owned
+	}
+
+	for _, purchase := range GetMtxPurchaseHistory(commonCore).Purchases {
+		if purchase.RefundDate != "" {
+			continue
+		}
+
+		for _, fulfillmentId := range purchase.Fulfillments {
+			owned[strings.ToLower(fulfillmentId)] += 1
+		}
+	}
+
+	return owned
+}
+
+// requirements are checked against whoever ends up with the items,
+// which is the buyer for purchases and the receiver for gifts
+func CheckCatalogEntryRequirements(offer models.CatalogEntry, athenaProfile *models.Profile, commonCore *models.Profile) error {
+	if ownsWholeBundle(offer, athenaProfile) {
+		return ErrRequirementOwnsItem
+	}
+
+	if len(offer.Requirements) == 0 {
+		return nil
+	}
+
+	items := ownedTemplateIds(athenaProfile, commonCore)
+	fulfillments := ownedFulfillments(commonCore)
+
+	for _, requirement := range offer.Requirements {
+		minQuantity := requirement.MinQuantity
+		if minQuantity < 1 {
+			minQuantity = 1
+		}
+		requiredId := strings.ToLower(requirement.RequiredID)
+
+		switch requirement.RequirementType {
+			case "DenyOnItemOwnership":
+				if items[requiredId] >= minQuantity {
+					return ErrRequirementOwnsItem
+				}
+			case "DenyOnFulfillment":
+				if fulfillments[requiredId] >= minQuantity {
+					return ErrRequirementFulfilled
+				}
+			case "RequireFulfillment":
+				if fulfillments[requiredId] < minQuantity {
+					return ErrRequirementNotFulfilled
+				}
+			default:
+				return ErrRequirementUnknown
+		}
+	}
+
+	return nil
+}
+
+func startOfDay(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(now time.Time) time.Time {
+	day := startOfDay(now)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func startOfMonth(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func CountOfferPurchasesSince(commonCore *models.Profile, offerId string, since time.Time) int {
+	count := 0
+	for _, purchase := range GetMtxPurchaseHistory(commonCore).Purchases {
+		// a gift is the sender buying for someone else, it doesn't use up their own limits
+		if purchase.OfferId != offerId || purchase.RefundDate != "" || IsGiftPurchase(purchase) {
+			continue
+		}
+
+		purchaseDate, err := time.Parse("2006-01-02T15:04:05.999Z", purchase.PurchaseDate)
+		if err != nil || purchaseDate.Before(since) {
+			continue
+		}
+
+		quantity := purchase.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		count += quantity
+	}
+
+	return count
+}
+
+// a limit below one means the offer can be bought any number of times
+func CheckCatalogEntryLimits(offer models.CatalogEntry, commonCore *models.Profile, quantity int) error {
+	now := time.Now().UTC()
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	limits := []struct {
+		limit int
+		since time.Time
+		err error
+	}{
+		{offer.DailyLimit, startOfDay(now), ErrDailyLimitReached},
+		{offer.WeeklyLimit, startOfWeek(now), ErrWeeklyLimitReached},
+		{offer.MonthlyLimit, startOfMonth(now), ErrMonthlyLimitReached},
+	}
+
+	for _, limit := range limits {
+		if limit.limit < 1 {
+			continue
+		}
+
+		if CountOfferPurchasesSince(commonCore, offer.OfferID, limit.since) + quantity > limit.limit {
+			return limit.err
+		}
+	}
+
+	return nil
+}
+
+func CheckCatalogEntryPurchase(offer models.CatalogEntry, athenaProfile *models.Profile, commonCore *models.Profile, quantity int) error {
+	err := CheckCatalogEntryRequirements(offer, athenaProfile, commonCore)
+	if err != nil {
+		return err
+	}
+
+	return CheckCatalogEntryLimits(offer, commonCore, quantity)
+}
@@ -0,0 +1,505 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+// read on every rotation so the shop can be tuned without a restart
+func GetShopRotationConfig() (models.ShopRotationConfig, error) {
+	file, err := os.Open("data/shop/rotation.json")
+	if err != nil {
+		return models.ShopRotationConfig{}, err
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return models.ShopRotationConfig{}, err
+	}
+	str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+	var config models.ShopRotationConfig
+	err = json.Unmarshal([]byte(str), &config)
+	if err != nil {
+		return models.ShopRotationConfig{}, err
+	}
+
+	if config.RefreshIntervalHrs < 1 {
+		config.RefreshIntervalHrs = 24
+	}
+
+	if config.DailyPurchaseHrs < 1 {
+		config.DailyPurchaseHrs = 24
+	}
+
+	if config.BattlePass.TierPrice < 1 {
+		config.BattlePass.TierPrice = 150
+	}
+
+	return config, nil
+}
+
+func shopEligible(config models.ShopRotationConfig, item models.BeforeStoreItem) bool {
+	if item.ID == "" {
+		return false
+	}
+
+	for _, backendType := range config.ExcludeTypes {
+		if item.BackendType == backendType {
+			return false
+		}
+	}
+
+	for _, id := range config.ExcludeIds {
+		if strings.EqualFold(item.ID, id) {
+			return false
+		}
+	}
+
+	eligibility := config.SeasonEligibility
+	return item.IntroductionSeason >= eligibility.MinIntroductionSeason && item.IntroductionSeason <= Season + eligibility.MaxSeasonsAhead
+}
+
+func GetShopEligibleItems(config models.ShopRotationConfig) ([]models.BeforeStoreItem, error) {
+	items := []models.BeforeStoreItem{}
+	for _, item := range GetItems() {
+		// the config is read on every rotation, so an unpriced type added since startup is skipped
+		if shopEligible(config, item) && HasItemPrice(item) {
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+func shopItemWeight(section models.ShopSectionConfig, item models.BeforeStoreItem) int {
+	weight := 1
+
+	if len(section.RarityWeights) > 0 {
+		weight *= section.RarityWeights[item.Rarity]
+	}
+
+	if len(section.TypeWeights) > 0 {
+		weight *= section.TypeWeights[item.BackendType]
+	}
+
+	return weight
+}
+
+func pickWeightedShopItem(random *rand.Rand, pool []models.BeforeStoreItem, section models.ShopSectionConfig, skip map[string]bool) (models.BeforeStoreItem, bool) {
+	total := 0
+	weights := make([]int, len(pool))
+	for i, item := range pool {
+		if skip[item.BackendType + ":" + item.ID] {
+			continue
+		}
+
+		weights[i] = shopItemWeight(section, item)
+		total += weights[i]
+	}
+
+	if total <= 0 {
+		return models.BeforeStoreItem{}, false
+	}
+
+	roll := random.Intn(total)
+	for i, weight := range weights {
+		if roll < weight {
+			return pool[i], true
+		}
+		roll -= weight
+	}
+
+	return models.BeforeStoreItem{}, false
+}
+
+// only days before the shop date count so regenerating a day gives the same shop
+func GetRecentlyShownShopItems(date time.Time, days int) map[string]bool {
+	shown := map[string]bool{}
+	if days < 1 {
+		return shown
+	}
+
+	since := date.AddDate(0, 0, -days).Format("2006-01-02")
+
+	var templateIds []string
+	all.Postgres.Model(&models.ShopItemAppearance{}).Where("date > ? AND date < ?", since, date.Format("2006-01-02")).Distinct("template_id").Pluck("template_id", &templateIds)
+
+	for _, templateId := range templateIds {
+		shown[templateId] = true
+	}
+
+	return shown
+}
+
+func generateShopSection(random *rand.Rand, date time.Time, section models.ShopSectionConfig, pool []models.BeforeStoreItem, sets map[string][]models.BeforeStoreItem, picked map[string]bool, priority int, expiration string) []models.CatalogEntry {
+	entries := []models.CatalogEntry{}
+
+	skip := GetRecentlyShownShopItems(date, section.CooldownDays)
+	for templateId := range picked {
+		skip[templateId] = true
+	}
+
+	bundles := generateShopBundles(random, section, skip, picked, priority, expiration)
+	priority -= len(bundles)
+
+	addEntry := func(item models.BeforeStoreItem) {
+		templateId := item.BackendType + ":" + item.ID
+		picked[templateId] = true
+		skip[templateId] = true
+
+		entries = append(entries, NewItemCatalogEntry(item, priority - len(entries), section, expiration))
+	}
+
+	for len(entries) < section.Slots {
+		item, ok := pickWeightedShopItem(random, pool, section, skip)
+		if !ok {
+			all.PrintRed([]any{"ran out of items for shop section", section.SectionId})
+			break
+		}
+		addEntry(item)
+
+		if !section.GroupSets || item.Set == "" {
+			continue
+		}
+
+		// the rest of the set fills the following slots so it shows up together
+		for _, setItem := range sets[item.Set] {
+			if len(entries) >= section.Slots {
+				break
+			}
+
+			if skip[setItem.BackendType + ":" + setItem.ID] || shopItemWeight(section, setItem) <= 0 {
+				continue
+			}
+			addEntry(setItem)
+		}
+	}
+
+	return append(bundles, entries...)
+}
+
+func seasonTemplate(value string) string {
+	return strings.ReplaceAll(value, "{season}", strconv.Itoa(Season))
+}
+
+func GenerateBattlePassStorefront(config models.ShopBattlePassConfig) (models.Storefront, bool) {
+	if !config.Enabled {
+		return models.Storefront{}, false
+	}
+
+	pass, err := GetBattlePass(Season)
+	if err != nil {
+		all.PrintRed([]any{"no battle pass for season", Season, "skipping battle pass storefront"})
+		return models.Storefront{}, false
+	}
+
+	description, ok := config.Descriptions[strconv.Itoa(Season)]
+	if !ok {
+		description = models.ShopBattlePassDescription{
+			Bundle: "Season " + strconv.Itoa(Season) + "\n\nBattle Pass + 25 tiers!",
+			Pass: "Season " + strconv.Itoa(Season) + "\n\nPlay to level up your Battle Pass and unlock rewards!",
+		}
+	}
+
+	passRequirement := []models.Requirement{{
+		RequirementType: "DenyOnFulfillment",
+		RequiredID: pass.BattlePassOfferId,
+		MinQuantity: 1,
+	}}
+
+	newEntry := func(offerId string, devName string, price int, regularPrice int) models.CatalogEntry {
+		return models.CatalogEntry{
+			OfferID: offerId,
+			DevName: "BR.Season" + strconv.Itoa(Season) + "." + devName + ".01",
+			OfferType: "StaticPrice",
+			Prices: []models.Price{{
+				CurrencyType: "MtxCurrency",
+				CurrencySubType: "",
+				RegularPrice: regularPrice,
+				FinalPrice: price,
+				SaleExpiration: "9999-12-31T23:59:59.999Z",
+				BasePrice: price,
+			}},
+			Categories: []string{},
+			FulfillmentIDs: []string{},
+			DailyLimit: -1,
+			WeeklyLimit: -1,
+			MonthlyLimit: -1,
+			AppStoreID: []string{"", "", "", "", "", "", "", "", "", ""},
+			Requirements: []models.Requirement{},
+			MetaInfo: []gin.H{},
+			CatalogGroupPriority: 0,
+			SortPriority: 0,
+			ItemGrants: []models.ItemGrant{},
+			Refundable: false,
+		}
+	}
+
+	bundle := newEntry(pass.BattleBundleOfferId, "BattleBundle", config.BundlePrice, config.BundleRegularPrice)
+	bundle.FulfillmentIDs = []string{pass.BattlePassOfferId}
+	bundle.Requirements = passRequirement
+	bundle.DisplayAssetPath = seasonTemplate(config.BundleDisplayAssetPath)
+	bundle.Title = "Battle Bundle"
+	bundle.ShortDescription = "Battle Pass + " + strconv.Itoa(BattleBundleTiers) + " tiers!"
+	bundle.Description = description.Bundle
+
+	battlePass := newEntry(pass.BattlePassOfferId, "BattlePass", config.PassPrice, config.PassPrice)
+	battlePass.FulfillmentIDs = []string{pass.BattlePassOfferId}
+	battlePass.Requirements = passRequirement
+	battlePass.DisplayAssetPath = seasonTemplate(config.PassDisplayAssetPath)
+	battlePass.Title = "Battle Pass"
+	battlePass.ShortDescription = "Season " + strconv.Itoa(Season)
+	battlePass.Description = description.Pass
+
+	tier := newEntry(pass.TierOfferId, "SingleTier", config.TierPrice, config.TierPrice)
+	tier.Title = "Battle Pass Tier"
+	tier.Description = "Get great rewards now!"
+
+	return models.Storefront{
+		Name: seasonTemplate(config.StorefrontName),
+		CatalogEntries: []models.CatalogEntry{bundle, battlePass, tier},
+	}, true
+}
+
+// the same date, season and reroll always produce the same shop
+func ShopSeed(date string, reroll int) int64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(date + ":" + strconv.Itoa(Season) + ":" + strconv.Itoa(reroll)))
+
+	return int64(hash.Sum64())
+}
+
+// the shop day starts at the most recent rollover and ends at the next one
+func ShopRollover(config models.ShopRotationConfig, now time.Time) (time.Time, time.Time) {
+	rolloverTime, err := time.Parse("15:04", config.RolloverTime)
+	if err != nil {
+		rolloverTime = time.Time{}
+	}
+
+	rollover := time.Date(now.Year(), now.Month(), now.Day(), rolloverTime.Hour(), rolloverTime.Minute(), 0, 0, now.Location())
+	if now.Before(rollover) {
+		rollover = rollover.AddDate(0, 0, -1)
+	}
+
+	return rollover, rollover.AddDate(0, 0, 1)
+}
+
+func NextShopRollover() time.Time {
+	config, err := GetShopRotationConfig()
+	if err != nil {
+		all.PrintRed([]any{"could not read shop rotation config", err.Error()})
+	}
+
+	_, next := ShopRollover(config, time.Now())
+	return next
+}
+
+// the day a shop belongs to, which only matches the calendar day when the rollover is at midnight
+func ShopDate(now time.Time) string {
+	config, _ := GetShopRotationConfig()
+	day, _ := ShopRollover(config, now)
+
+	return day.Format("2006-01-02")
+}
+
+func shopExpiration(config models.ShopRotationConfig, day time.Time) string {
+	_, next := ShopRollover(config, day)
+	return next.Add(-time.Millisecond).Format("2006-01-02T15:04:05.999Z")
+}
+
+func BuildItemShop(config models.ShopRotationConfig, day time.Time, seed int64) (models.StorePage, error) {
+	pool, err := GetShopEligibleItems(config)
+	if err != nil {
+		return models.StorePage{}, err
+	}
+
+	sets := map[string][]models.BeforeStoreItem{}
+	for _, item := range pool {
+		if item.Set != "" {
+			sets[item.Set] = append(sets[item.Set], item)
+		}
+	}
+
+	random := rand.New(rand.NewSource(seed))
+	expiration := shopExpiration(config, day)
+
+	page := models.StorePage{
+		RefreshIntervalHrs: config.RefreshIntervalHrs,
+		DailyPurchaseHrs: config.DailyPurchaseHrs,
+		Expiration: expiration,
+		Storefronts: []models.Storefront{},
+	}
+
+	picked := map[string]bool{}
+	for _, storefrontConfig := range config.Storefronts {
+		storefront := models.Storefront{
+			Name: storefrontConfig.Name,
+			CatalogEntries: []models.CatalogEntry{},
+		}
+
+		for _, section := range storefrontConfig.Sections {
+			priority := -len(storefront.CatalogEntries) - 1
+			storefront.CatalogEntries = append(storefront.CatalogEntries, generateShopSection(random, day, section, pool, sets, picked, priority, expiration)...)
+		}
+
+		page.Storefronts = append(page.Storefronts, storefront)
+	}
+
+	if storefront, ok := GenerateBattlePassStorefront(config.BattlePass); ok {
+		page.Storefronts = append(page.Storefronts, storefront)
+	}
+
+	return page, nil
+}
+
+// a planned shop wins over the random one unless an admin asks for a reroll,
+// a reroll moves the day on to the next seed, otherwise the day's last seed is reused
+func GenerateItemShop(reroll bool) (models.StorePage, error) {
+	config, err := GetShopRotationConfig()
+	if err != nil {
+		return models.StorePage{}, err
+	}
+
+	day, _ := ShopRollover(config, time.Now())
+	date := day.Format("2006-01-02")
+
+	if !reroll {
+		plan, err := GetShopPlan(date)
+		if err == nil {
+			page, err := BuildPlannedItemShop(config, plan, day)
+			if err == nil {
+				RecordShopAppearances(date, page)
+				SaveShopHistory(date, 0, 0, page)
+
+				return page, nil
+			}
+			all.PrintRed([]any{"could not build shop plan for", date, err.Error(), "falling back to random shop"})
+		}
+	}
+
+	rerolls := 0
+	history, err := GetShopHistory(date)
+	if err == nil {
+		rerolls = history.Reroll
+	}
+
+	if reroll {
+		rerolls += 1
+	}
+
+	seed := ShopSeed(date, rerolls)
+	page, err := BuildItemShop(config, day, seed)
+	if err != nil {
+		return models.StorePage{}, err
+	}
+
+	RecordShopAppearances(date, page)
+	SaveShopHistory(date, rerolls, seed, page)
+
+	return page, nil
+}
+
+func RecordShopAppearances(date string, page models.StorePage) {
+	appearances := []models.ShopItemAppearance{}
+
+	for _, storefront := range page.Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			sectionId, _ := entry.Meta["SectionId"].(string)
+			for _, grant := range entry.ItemGrants {
+				appearances = append(appearances, models.ShopItemAppearance{
+					Date: date,
+					TemplateId: grant.TemplateID,
+					Storefront: storefront.Name,
+					SectionId: sectionId,
+				})
+			}
+		}
+	}
+
+	all.Postgres.Unscoped().Where("date = ?", date).Delete(&models.ShopItemAppearance{})
+
+	if len(appearances) == 0 {
+		return
+	}
+
+	result := all.Postgres.Create(&appearances)
+	if result.Error != nil {
+		all.PrintRed([]any{"could not record shop appearances", result.Error.Error()})
+	}
+}
+
+func GetShopHistory(date string) (models.ShopHistory, error) {
+	var history models.ShopHistory
+	result := all.Postgres.Where("date = ?", date).First(&history)
+	if result.Error != nil {
+		return models.ShopHistory{}, result.Error
+	}
+
+	return history, nil
+}
+
+func GetShopHistoryDates() []string {
+	var dates []string
+	all.Postgres.Model(&models.ShopHistory{}).Order("date desc").Pluck("date", &dates)
+
+	return dates
+}
+
+func SaveShopHistory(date string, reroll int, seed int64, page models.StorePage) {
+	shop, err := json.Marshal(page)
+	if err != nil {
+		all.PrintRed([]any{"could not marshal shop history", err.Error()})
+		return
+	}
+
+	history, err := GetShopHistory(date)
+	if err != nil {
+		history = models.ShopHistory{
+			Date: date,
+		}
+	}
+
+	history.Reroll = reroll
+	history.Seed = seed
+	history.Shop = string(shop)
+
+	result := all.Postgres.Save(&history)
+	if result.Error != nil {
+		all.PrintRed([]any{"could not save shop history", date, result.Error.Error()})
+	}
+}
+
+// only one caller gets true for each shop, so a restart or a second load
+// of the same shop doesn't announce it again
+func ClaimShopAnnouncement(date string, etag string) bool {
+	result := all.Postgres.Model(&models.ShopHistory{}).Where("date = ? AND (announced_etag IS NULL OR announced_etag <> ?)", date, etag).Update("announced_etag", etag)
+	if result.Error != nil {
+		all.PrintRed([]any{"could not mark shop as announced", date, result.Error.Error()})
+		return false
+	}
+
+	return result.RowsAffected > 0
+}
+
+func GetShopHistoryPage(history models.ShopHistory) (models.StorePage, error) {
+	var page models.StorePage
+	err := json.Unmarshal([]byte(history.Shop), &page)
+	if err != nil {
+		return models.StorePage{}, err
+	}
+
+	return page, nil
+}
@@ -0,0 +1,234 @@
+package common
+
+import (
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var (
+	ErrShopSaleNoTarget = errors.New("sale needs a templateId or a storefront")
+	ErrShopSaleInvalidDiscount = errors.New("sale discount must be between 1 and 99 percent")
+	ErrShopSaleInvalidTime = errors.New("sale must end after it starts")
+	ErrShopSaleNotFound = errors.New("sale not found")
+
+	activeSales atomic.Pointer[activeSalesSnapshot]
+	shopSalesVersion atomic.Int64
+)
+
+// active sales are kept until one starts or ends, or the sales are edited
+type activeSalesSnapshot struct {
+	sales []models.ShopSale
+	etag string
+	version int64
+	refreshAt time.Time
+}
+
+func CreateShopSale(sale models.ShopSale) (models.ShopSale, error) {
+	if sale.TemplateId == "" && sale.Storefront == "" {
+		return models.ShopSale{}, ErrShopSaleNoTarget
+	}
+
+	if sale.DiscountPercent < 1 || sale.DiscountPercent > 99 {
+		return models.ShopSale{}, ErrShopSaleInvalidDiscount
+	}
+
+	if sale.StartsAt.IsZero() {
+		sale.StartsAt = time.Now()
+	}
+
+	if !sale.EndsAt.After(sale.StartsAt) || !sale.EndsAt.After(time.Now()) {
+		return models.ShopSale{}, ErrShopSaleInvalidTime
+	}
+
+	result := all.Postgres.Create(&sale)
+	if result.Error != nil {
+		return models.ShopSale{}, result.Error
+	}
+	shopSalesVersion.Add(1)
+
+	return sale, nil
+}
+
+func DeleteShopSale(id string) error {
+	result := all.Postgres.Where("id = ?", id).Delete(&models.ShopSale{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrShopSaleNotFound
+	}
+	shopSalesVersion.Add(1)
+
+	return nil
+}
+
+// sales that are running now or still to come
+func GetShopSales() []models.ShopSale {
+	var sales []models.ShopSale
+	all.Postgres.Where("ends_at > ?", time.Now()).Order("starts_at").Find(&sales)
+
+	return sales
+}
+
+func getActiveSalesSnapshot(now time.Time) *activeSalesSnapshot {
+	version := shopSalesVersion.Load()
+
+	cached := activeSales.Load()
+	if cached != nil && cached.version == version && now.Before(cached.refreshAt) {
+		return cached
+	}
+
+	var upcoming []models.ShopSale
+	result := all.Postgres.Where("ends_at > ?", now).Order("starts_at").Find(&upcoming)
+	if result.Error != nil {
+		all.PrintRed([]any{"could not load shop sales", result.Error.Error()})
+		return &activeSalesSnapshot{sales: []models.ShopSale{}}
+	}
+
+	snapshot := &activeSalesSnapshot{
+		sales: []models.ShopSale{},
+		version: version,
+		refreshAt: now.Add(time.Hour),
+	}
+
+	for _, sale := range upcoming {
+		if sale.StartsAt.After(now) {
+			if sale.StartsAt.Before(snapshot.refreshAt) {
+				snapshot.refreshAt = sale.StartsAt
+			}
+			continue
+		}
+
+		snapshot.sales = append(snapshot.sales, sale)
+		if sale.EndsAt.Before(snapshot.refreshAt) {
+			snapshot.refreshAt = sale.EndsAt
+		}
+	}
+	snapshot.etag = salesETag(snapshot.sales)
+
+	activeSales.Store(snapshot)
+	return snapshot
+}
+
+func GetActiveShopSales(now time.Time) []models.ShopSale {
+	return getActiveSalesSnapshot(now).sales
+}
+
+func shopSaleFor(sales []models.ShopSale, storefront string, entry models.CatalogEntry) (models.ShopSale, bool) {
+	best := models.ShopSale{}
+	found := false
+
+	for _, sale := range sales {
+		matches := sale.Storefront != "" && sale.Storefront == storefront
+		for _, grant := range entry.ItemGrants {
+			if sale.TemplateId != "" && sale.TemplateId == grant.TemplateID {
+				matches = true
+			}
+		}
+
+		if matches && sale.DiscountPercent > best.DiscountPercent {
+			best = sale
+			found = true
+		}
+	}
+
+	return best, found
+}
+
+// sales are applied when the catalog is read so they start and stop on time
+// instead of waiting for the next rotation
+func applyShopSale(entry models.CatalogEntry, sale models.ShopSale) models.CatalogEntry {
+	if len(entry.Prices) == 0 {
+		return entry
+	}
+
+	saleExpiration := sale.EndsAt.Format("2006-01-02T15:04:05.999Z")
+
+	prices := make([]models.Price, len(entry.Prices))
+	copy(prices, entry.Prices)
+	prices[0].FinalPrice = discountPrice(prices[0].FinalPrice, sale.DiscountPercent)
+	prices[0].SaleExpiration = saleExpiration
+	entry.Prices = prices
+
+	if entry.DynamicBundleInfo != nil {
+		bundleInfo := *entry.DynamicBundleInfo
+		bundleInfo.DiscountedBasePrice = discountPrice(bundleInfo.DiscountedBasePrice, sale.DiscountPercent)
+		bundleInfo.BundleItems = make([]models.DynamicBundleItem, len(entry.DynamicBundleInfo.BundleItems))
+
+		for i, bundleItem := range entry.DynamicBundleInfo.BundleItems {
+			bundleItem.DiscountedPrice = discountPrice(bundleItem.DiscountedPrice, sale.DiscountPercent)
+			bundleItem.AlreadyOwnedPriceReduction = bundleItem.DiscountedPrice
+			bundleInfo.BundleItems[i] = bundleItem
+		}
+
+		entry.DynamicBundleInfo = &bundleInfo
+	}
+
+	return entry
+}
+
+func plannedSaleActive(entry models.CatalogEntry, now time.Time) bool {
+	if entry.PlannedSale == nil || len(entry.Prices) == 0 {
+		return false
+	}
+
+	ends, err := time.Parse("2006-01-02T15:04:05.999Z", entry.PlannedSale.Expiration)
+	return err == nil && now.Before(ends)
+}
+
+func applyPlannedSale(entry models.CatalogEntry) models.CatalogEntry {
+	prices := make([]models.Price, len(entry.Prices))
+	copy(prices, entry.Prices)
+	prices[0].FinalPrice = entry.PlannedSale.FinalPrice
+	prices[0].SaleExpiration = entry.PlannedSale.Expiration
+	entry.Prices = prices
+
+	return entry
+}
+
+// sales don't stack, the entry gets whichever of the planned sale and the shop sale is cheaper
+func ApplyShopSalesToEntry(storefront string, entry models.CatalogEntry, sales []models.ShopSale) models.CatalogEntry {
+	planned := entry
+	if plannedSaleActive(entry, time.Now()) {
+		planned = applyPlannedSale(entry)
+	}
+
+	sale, ok := shopSaleFor(sales, storefront, entry)
+	if !ok {
+		return planned
+	}
+
+	discounted := applyShopSale(entry, sale)
+	if len(discounted.Prices) > 0 && planned.Prices[0].FinalPrice < discounted.Prices[0].FinalPrice {
+		return planned
+	}
+
+	return discounted
+}
+
+func ApplyShopSales(page models.StorePage) models.StorePage {
+	return applyShopSales(page, GetActiveShopSales(time.Now()))
+}
+
+func applyShopSales(page models.StorePage, sales []models.ShopSale) models.StorePage {
+	storefronts := make([]models.Storefront, len(page.Storefronts))
+	for i, storefront := range page.Storefronts {
+		entries := make([]models.CatalogEntry, len(storefront.CatalogEntries))
+		for j, entry := range storefront.CatalogEntries {
+			entries[j] = ApplyShopSalesToEntry(storefront.Name, entry, sales)
+		}
+
+		storefronts[i] = models.Storefront{
+			Name: storefront.Name,
+			CatalogEntries: entries,
+		}
+	}
+	page.Storefronts = storefronts
+
+	return page
+}
@@ -0,0 +1,205 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	SeasonEndRewards map[string][]models.SeasonEndReward
+
+	ErrSeasonNotConfigured = errors.New("season does not match the configured SEASON")
+)
+
+func GetSeasonEndRewards(season int) []models.SeasonEndReward {
+	if SeasonEndRewards == nil {
+		file, err := os.Open("data/season_rewards.json")
+		if err != nil {
+			return []models.SeasonEndReward{}
+		}
+		defer file.Close()
+
+		fileData, err := io.ReadAll(file)
+		if err != nil {
+			return []models.SeasonEndReward{}
+		}
+		str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+		var rewards map[string][]models.SeasonEndReward
+		err = json.Unmarshal([]byte(str), &rewards)
+		if err != nil {
+			all.PrintRed([]any{"could not read season rewards", err.Error()})
+			return []models.SeasonEndReward{}
+		}
+		SeasonEndRewards = rewards
+	}
+
+	return SeasonEndRewards[strconv.Itoa(season)]
+}
+
+// season_num is rewritten to the current season by every profile request, so
+// the season a profile was last rolled over to is kept on its row instead
+func getRolledSeason(accountId string) int {
+	var userProfile models.UserProfile
+	all.Postgres.Select("rolled_season").Where("account_id = ? AND profile_id = ?", accountId, "athena").First(&userProfile)
+
+	return userProfile.RolledSeason
+}
+
+func RolloverProfileSeason(accountId string, newSeason int) (bool, error) {
+	profile, err := ReadProfileFromUser(accountId, "athena")
+	if err != nil {
+		return false, err
+	}
+
+	athenaProfile, err := ConvertProfileToAthena(profile)
+	if err != nil {
+		return false, err
+	}
+
+	oldSeason := getRolledSeason(accountId)
+	if oldSeason == newSeason {
+		all.Postgres.Model(&models.UserProfile{}).Where("account_id = ? AND profile_id = ?", accountId, "athena").Update("rolled_season", newSeason)
+		return false, nil
+	}
+
+	oldStats := athenaProfile.Stats.Attributes
+	marshalledStats, err := json.Marshal(profile.Stats.Attributes)
+	if err != nil {
+		return false, err
+	}
+
+	lootList := []models.LootResultItem{}
+	for _, reward := range GetSeasonEndRewards(oldSeason) {
+		if oldStats.BookLevel < reward.MinBookLevel || (reward.RequiresBattlePass && !oldStats.BookPurchased) {
+			continue
+		}
+
+		if _, ok := profile.Items[reward.TemplateId]; ok {
+			continue
+		}
+
+		quantity := reward.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+
+		AddItemToProfile(&profile, reward.TemplateId, accountId)
+		if item, ok := profile.Items[reward.TemplateId].(models.Item); ok {
+			item.Quantity = quantity
+			profile.Items[reward.TemplateId] = item
+		}
+
+		lootList = append(lootList, models.LootResultItem{
+			ItemType: reward.TemplateId,
+			ItemGuid: reward.TemplateId,
+			ItemProfile: "athena",
+			Quantity: quantity,
+		})
+	}
+
+	attributes := profile.Stats.Attributes
+	attributes["season_num"] = newSeason
+	attributes["level"] = 1
+	attributes["xp"] = 0
+	attributes["book_level"] = 1
+	attributes["book_xp"] = 0
+	attributes["book_purchased"] = false
+	attributes["purchased_battle_pass_tier_offers"] = []string{}
+	attributes["xp_overflow"] = 0
+	attributes["rested_xp_overflow"] = 0
+	attributes["season_match_boost"] = 0
+	attributes["season_friend_match_boost"] = 0
+	profile.WipeNumber++
+	RemoveSeasonQuests(&profile)
+
+	// the history row, the wiped profile and the marker are saved together so
+	// a failed rollover can be run again without writing a second history row
+	rolled := false
+	err = all.Postgres.Transaction(func(tx *gorm.DB) error {
+		var userProfile models.UserProfile
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("account_id = ? AND profile_id = ?", accountId, "athena").First(&userProfile)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if userProfile.RolledSeason == newSeason {
+			return nil
+		}
+
+		result = tx.Create(&models.SeasonHistory{
+			AccountId: accountId,
+			Season: oldSeason,
+			WipeNumber: profile.WipeNumber - 1,
+			Level: oldStats.Level,
+			Xp: oldStats.Xp,
+			BookLevel: oldStats.BookLevel,
+			BookXp: oldStats.BookXp,
+			BookPurchased: oldStats.BookPurchased,
+			Stats: string(marshalledStats),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		err := saveProfileWith(tx, accountId, profile)
+		if err != nil {
+			return err
+		}
+
+		rolled = true
+		return tx.Model(&models.UserProfile{}).Where("id = ?", userProfile.ID).Update("rolled_season", newSeason).Error
+	})
+	if err != nil || !rolled {
+		return false, err
+	}
+
+	if len(lootList) > 0 {
+		commonCore, err := ReadProfileFromUser(accountId, "common_core")
+		if err != nil {
+			return true, err
+		}
+
+		AddGiftBoxToProfile(&commonCore, NewGiftBox("", "Server", lootList, "Thanks for playing Season " + strconv.Itoa(oldSeason) + "!"))
+		SaveProfileToUser(accountId, commonCore)
+	}
+
+	all.PrintGreen([]any{"rolled", accountId, "over from season", oldSeason, "to", newSeason})
+
+	return true, nil
+}
+
+// the season comes from SEASON so a restart can't undo a rollover
+func RolloverSeason(newSeason int) ([]string, error) {
+	if newSeason != Season {
+		return []string{}, ErrSeasonNotConfigured
+	}
+
+	var userProfiles []models.UserProfile
+	all.Postgres.Select("account_id").Where("profile_id = ?", "athena").Find(&userProfiles)
+
+	rolledAccountIds := []string{}
+	for _, userProfile := range userProfiles {
+		rolled, err := RolloverProfileSeason(userProfile.AccountId, newSeason)
+		if err != nil {
+			all.PrintRed([]any{"could not roll over season for", userProfile.AccountId, err.Error()})
+		}
+
+		if rolled {
+			rolledAccountIds = append(rolledAccountIds, userProfile.AccountId)
+		}
+	}
+
+	all.PrintGreen([]any{"season rollover to", newSeason, "finished for", len(rolledAccountIds), "accounts"})
+
+	return rolledAccountIds, nil
+}
@@ -5,6 +5,9 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"time"
+
+	"github.com/zombman/server/all"
 )
 
 type GameServer struct {
@@ -22,6 +25,14 @@ var (
 	Season           int    = 0
 	LoadShopFromJson bool   = false
 	Season6HalloweenLobby bool = false
+	MaxLoadoutPresets int    = 10
+	MaxWishlistItems int     = 50
+	MaxDailyGifts    int     = 5
+	MinGiftFriendshipAge     = time.Hour * 48
+	WebhookUrl       string  = ""
+	WebhookSecret    string  = ""
+	SeasonStart      string  = ""
+	ServerStarted    time.Time = time.Now()
 )
 
 func InitGameServers() {
@@ -30,6 +41,29 @@ func InitGameServers() {
 	Season = seasonEnv
 	IP = os.Getenv("BACKEND_IP")
 
+	if maxLoadoutPresets, err := strconv.Atoi(os.Getenv("USER_MAX_LOADOUT_PRESETS")); err == nil {
+		MaxLoadoutPresets = maxLoadoutPresets
+	}
+
+	if maxWishlistItems, err := strconv.Atoi(os.Getenv("USER_MAX_WISHLIST_ITEMS")); err == nil {
+		MaxWishlistItems = maxWishlistItems
+	}
+
+	if maxDailyGifts, err := strconv.Atoi(os.Getenv("USER_MAX_DAILY_GIFTS")); err == nil {
+		MaxDailyGifts = maxDailyGifts
+	}
+
+	if friendshipHours, err := strconv.Atoi(os.Getenv("USER_GIFT_FRIENDSHIP_HOURS")); err == nil {
+		MinGiftFriendshipAge = time.Hour * time.Duration(friendshipHours)
+	}
+
+	WebhookUrl = os.Getenv("WEBHOOK_URL")
+	WebhookSecret = os.Getenv("WEBHOOK_SECRET")
+	if WebhookUrl != "" && WebhookSecret == "" {
+		all.PrintYellow([]any{"WEBHOOK_URL is set without WEBHOOK_SECRET, no webhooks will be sent"})
+	}
+	SeasonStart = os.Getenv("SEASON_START")
+
 	addGameServer("playlist_defaultsolo", "EU", "127.0.0.1", 7777)
 	addGameServer("playlist_defaultsolo", "NAE", "127.0.0.1", 7777)
 	addGameServer("playlist_defaultsolo", "NAW", "127.0.0.1", 7777)
@@ -0,0 +1,210 @@
+package common
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/zombman/server/models"
+)
+
+var (
+	ShopImageColumns = 6
+	shopImageTile = 200
+	shopImagePadding = 16
+	shopImagePriceBar = 36
+
+	shopImageMutex sync.Mutex
+	shopImageETag string
+	shopImageData []byte
+
+	shopImageBackground = color.RGBA{24, 24, 32, 255}
+	shopImagePriceBackground = color.RGBA{0, 0, 0, 180}
+	shopImageRarities = map[string]color.RGBA{
+		"Common": {106, 109, 112, 255},
+		"Uncommon": {49, 146, 54, 255},
+		"Rare": {49, 143, 206, 255},
+		"Epic": {177, 91, 226, 255},
+		"Legendary": {211, 120, 65, 255},
+		"Mythic": {186, 156, 54, 255},
+	}
+
+	// there is no font renderer in the standard library so prices use a small bitmap font
+	shopImageDigits = map[rune][5]string{
+		'0': {"111", "101", "101", "101", "111"},
+		'1': {"010", "110", "010", "010", "111"},
+		'2': {"111", "001", "111", "100", "111"},
+		'3': {"111", "001", "111", "001", "111"},
+		'4': {"101", "101", "111", "001", "001"},
+		'5': {"111", "100", "111", "001", "111"},
+		'6': {"111", "100", "111", "101", "111"},
+		'7': {"111", "001", "001", "001", "001"},
+		'8': {"111", "101", "111", "101", "111"},
+		'9': {"111", "101", "111", "001", "111"},
+	}
+)
+
+type shopImageTileInfo struct {
+	item models.BeforeStoreItem
+	price int
+}
+
+func loadPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return png.Decode(file)
+}
+
+// nearest neighbour is enough for previews and keeps this free of extra dependencies
+func drawScaled(dst draw.Image, rect image.Rectangle, src image.Image) {
+	bounds := src.Bounds()
+	scaled := image.NewRGBA(image.Rect(0, 0, rect.Dx(), rect.Dy()))
+
+	for y := 0; y < rect.Dy(); y++ {
+		for x := 0; x < rect.Dx(); x++ {
+			scaled.Set(x, y, src.At(bounds.Min.X + x * bounds.Dx() / rect.Dx(), bounds.Min.Y + y * bounds.Dy() / rect.Dy()))
+		}
+	}
+
+	draw.Draw(dst, rect, scaled, image.Point{}, draw.Over)
+}
+
+func drawNumber(dst draw.Image, at image.Point, number int, scale int) {
+	x := at.X
+	for _, digit := range strconv.Itoa(number) {
+		glyph := shopImageDigits[digit]
+		for row, line := range glyph {
+			for column, pixel := range line {
+				if pixel != '1' {
+					continue
+				}
+
+				rect := image.Rect(x + column * scale, at.Y + row * scale, x + (column + 1) * scale, at.Y + (row + 1) * scale)
+				draw.Draw(dst, rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+			}
+		}
+		x += 4 * scale
+	}
+}
+
+func shopImageTiles(storefront models.Storefront) []shopImageTileInfo {
+	tiles := []shopImageTileInfo{}
+	for _, entry := range storefront.CatalogEntries {
+		if len(entry.ItemGrants) == 0 || len(entry.Prices) == 0 {
+			continue
+		}
+
+		item, ok := GetItem(entry.ItemGrants[0].TemplateID)
+		if !ok {
+			continue
+		}
+
+		tiles = append(tiles, shopImageTileInfo{
+			item: item,
+			price: entry.Prices[0].FinalPrice,
+		})
+	}
+
+	return tiles
+}
+
+// every storefront gets its own block in page order, the battle pass has no item art to show
+func RenderShopImage(page models.StorePage) ([]byte, error) {
+	sections := [][]shopImageTileInfo{}
+	for _, storefront := range page.Storefronts {
+		if seasonStorefrontPattern.MatchString(storefront.Name) {
+			continue
+		}
+
+		if tiles := shopImageTiles(storefront); len(tiles) > 0 {
+			sections = append(sections, tiles)
+		}
+	}
+
+	rows := 0
+	for _, tiles := range sections {
+		rows += (len(tiles) + ShopImageColumns - 1) / ShopImageColumns
+	}
+
+	step := shopImageTile + shopImagePadding
+	width := shopImagePadding + ShopImageColumns * step
+	height := shopImagePadding + rows * step
+	if len(sections) > 1 {
+		height += shopImagePadding * (len(sections) - 1)
+	}
+	canvas := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(shopImageBackground), image.Point{}, draw.Src)
+
+	vbuck, err := loadPNG("public/vbuck.png")
+	if err != nil {
+		return []byte{}, err
+	}
+
+	y := shopImagePadding
+	for _, tiles := range sections {
+		for i, tile := range tiles {
+			x := shopImagePadding + (i % ShopImageColumns) * step
+			top := y + (i / ShopImageColumns) * step
+			rect := image.Rect(x, top, x + shopImageTile, top + shopImageTile)
+
+			background, ok := shopImageRarities[tile.item.Rarity]
+			if !ok {
+				background = shopImageRarities["Common"]
+			}
+			draw.Draw(canvas, rect, image.NewUniform(background), image.Point{}, draw.Src)
+
+			// same file the /cid/:cid route serves
+			if preview, err := loadPNG("public/custom_cid_preview/" + tile.item.ID + ".png"); err == nil {
+				drawScaled(canvas, rect, preview)
+			}
+
+			bar := image.Rect(x, rect.Max.Y - shopImagePriceBar, rect.Max.X, rect.Max.Y)
+			draw.Draw(canvas, bar, image.NewUniform(shopImagePriceBackground), image.Point{}, draw.Over)
+
+			icon := shopImagePriceBar - 8
+			drawScaled(canvas, image.Rect(bar.Min.X + 4, bar.Min.Y + 4, bar.Min.X + 4 + icon, bar.Min.Y + 4 + icon), vbuck)
+			drawNumber(canvas, image.Point{bar.Min.X + icon + 12, bar.Min.Y + 8}, tile.price, 4)
+		}
+
+		y += (len(tiles) + ShopImageColumns - 1) / ShopImageColumns * step + shopImagePadding
+	}
+
+	var buffer bytes.Buffer
+	err = png.Encode(&buffer, canvas)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// rendering is slow enough to cache, the etag changes with the rotation and with sales
+func GetShopImage() ([]byte, string, error) {
+	page, etag := GetCatalogForClient("")
+
+	shopImageMutex.Lock()
+	defer shopImageMutex.Unlock()
+
+	if shopImageETag == etag {
+		return shopImageData, etag, nil
+	}
+
+	data, err := RenderShopImage(page)
+	if err != nil {
+		return []byte{}, "", err
+	}
+
+	shopImageETag = etag
+	shopImageData = data
+
+	return data, etag, nil
+}
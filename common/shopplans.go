@@ -0,0 +1,307 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var (
+	ErrShopPlanInvalidDate = errors.New("invalid shop plan date")
+	ErrShopPlanInPast = errors.New("shop plan date has already passed")
+	ErrShopPlanEmpty = errors.New("shop plan has no entries")
+	ErrShopPlanUnknownStorefront = errors.New("shop plan storefront has no name")
+	ErrShopPlanUnknownItem = errors.New("shop plan item does not exist")
+	ErrShopPlanInvalidPrice = errors.New("shop plan price is invalid")
+	ErrShopPlanInvalidSale = errors.New("shop plan sale is invalid")
+	ErrShopPlanNotFound = errors.New("shop plan not found")
+	ErrShopPlanBundlesUnsupported = errors.New("shop plan bundles need a newer season")
+)
+
+func GetShopPlan(date string) (models.ShopPlanRequest, error) {
+	var plan models.ShopPlan
+	result := all.Postgres.Where("date = ?", date).First(&plan)
+	if result.Error != nil {
+		return models.ShopPlanRequest{}, ErrShopPlanNotFound
+	}
+
+	var request models.ShopPlanRequest
+	err := json.Unmarshal([]byte(plan.Plan), &request)
+	if err != nil {
+		return models.ShopPlanRequest{}, err
+	}
+
+	return request, nil
+}
+
+func GetUpcomingShopPlans() []models.ShopPlan {
+	config, _ := GetShopRotationConfig()
+	day, _ := ShopRollover(config, time.Now())
+
+	var plans []models.ShopPlan
+	all.Postgres.Where("date >= ?", day.Format("2006-01-02")).Order("date").Find(&plans)
+
+	return plans
+}
+
+func ValidateShopPlan(request models.ShopPlanRequest) error {
+	date, err := time.ParseInLocation("2006-01-02", request.Date, time.Local)
+	if err != nil {
+		return ErrShopPlanInvalidDate
+	}
+
+	config, _ := GetShopRotationConfig()
+	day, _ := ShopRollover(config, time.Now())
+	if date.Format("2006-01-02") < day.Format("2006-01-02") {
+		return ErrShopPlanInPast
+	}
+
+	entries := 0
+	for _, storefront := range request.Storefronts {
+		if storefront.Name == "" {
+			return ErrShopPlanUnknownStorefront
+		}
+
+		for _, entry := range storefront.Entries {
+			if entry.Set != "" {
+				if Season < DynamicBundleMinSeason {
+					return fmt.Errorf("%s: %w", entry.Set, ErrShopPlanBundlesUnsupported)
+				}
+
+				if len(GetBundleItems(entry.Set)) == 0 {
+					return fmt.Errorf("%s: %w", entry.Set, ErrShopPlanUnknownItem)
+				}
+
+				if entry.BundleDiscount < 0 || entry.BundleDiscount > 99 {
+					return ErrShopPlanInvalidSale
+				}
+			} else if len(entry.TemplateIds) == 0 {
+				return ErrShopPlanEmpty
+			}
+
+			for _, templateId := range entry.TemplateIds {
+				item, ok := GetItem(templateId)
+				if !ok {
+					return fmt.Errorf("%s: %w", templateId, ErrShopPlanUnknownItem)
+				}
+
+				// without a price of its own the entry is priced from the table
+				if entry.Price == 0 && !HasItemPrice(item) {
+					return fmt.Errorf("%s: %w", templateId, ErrShopPlanInvalidPrice)
+				}
+			}
+
+			if entry.Price < 0 {
+				return ErrShopPlanInvalidPrice
+			}
+
+			if entry.SalePrice < 0 || (entry.SalePrice > 0 && entry.Price > 0 && entry.SalePrice >= entry.Price) {
+				return ErrShopPlanInvalidSale
+			}
+
+			if entry.SaleExpiration != "" {
+				if _, err := time.Parse("2006-01-02T15:04:05.999Z", entry.SaleExpiration); err != nil {
+					return ErrShopPlanInvalidSale
+				}
+			}
+
+			entries++
+		}
+	}
+
+	if entries == 0 && !request.BattlePass {
+		return ErrShopPlanEmpty
+	}
+
+	return nil
+}
+
+func SaveShopPlan(request models.ShopPlanRequest, createdBy string) (models.ShopPlan, error) {
+	err := ValidateShopPlan(request)
+	if err != nil {
+		return models.ShopPlan{}, err
+	}
+
+	marshal, err := json.Marshal(request)
+	if err != nil {
+		return models.ShopPlan{}, err
+	}
+
+	var plan models.ShopPlan
+	all.Postgres.Where("date = ?", request.Date).First(&plan)
+
+	plan.Date = request.Date
+	plan.Plan = string(marshal)
+	plan.CreatedBy = createdBy
+
+	result := all.Postgres.Save(&plan)
+	if result.Error != nil {
+		return models.ShopPlan{}, result.Error
+	}
+
+	return plan, nil
+}
+
+func DeleteShopPlan(date string) error {
+	result := all.Postgres.Unscoped().Where("date = ?", date).Delete(&models.ShopPlan{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrShopPlanNotFound
+	}
+
+	return nil
+}
+
+// bundles are priced as the sum of their items unless the plan sets a price,
+// a set turns the entry into a dynamic bundle priced from its items instead
+func NewPlannedCatalogEntry(planEntry models.ShopPlanEntry, priority int, expiration string) (models.CatalogEntry, error) {
+	section := models.ShopSectionConfig{
+		SectionId: planEntry.SectionId,
+		TileSize: planEntry.TileSize,
+		Refundable: planEntry.Refundable,
+		BundleDiscount: planEntry.BundleDiscount,
+	}
+
+	if planEntry.Set != "" {
+		items := GetBundleItems(planEntry.Set)
+		if len(items) == 0 {
+			return models.CatalogEntry{}, fmt.Errorf("%s: %w", planEntry.Set, ErrShopPlanUnknownItem)
+		}
+
+		entry := NewBundleCatalogEntry(planEntry.Set, items, priority, section, expiration)
+		if planEntry.Title != "" {
+			entry.Title = planEntry.Title
+		}
+
+		return entry, nil
+	}
+
+	items := []models.BeforeStoreItem{}
+	for _, templateId := range planEntry.TemplateIds {
+		item, ok := GetItem(templateId)
+		if !ok {
+			return models.CatalogEntry{}, fmt.Errorf("%s: %w", templateId, ErrShopPlanUnknownItem)
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return models.CatalogEntry{}, ErrShopPlanEmpty
+	}
+
+	entry := NewItemCatalogEntry(items[0], priority, section, expiration)
+
+	price := planEntry.Price
+	if price == 0 {
+		for _, item := range items {
+			price += GetItemPrice(item)
+		}
+	}
+
+	// these aren't dynamic bundles, so owning any of the items blocks the whole entry
+	if len(items) > 1 {
+		templateIds := []string{}
+		entry.Requirements = []models.Requirement{}
+		entry.ItemGrants = []models.ItemGrant{}
+
+		for _, item := range items {
+			templateId := item.BackendType + ":" + item.ID
+			templateIds = append(templateIds, templateId)
+
+			entry.Requirements = append(entry.Requirements, models.Requirement{
+				RequirementType: "DenyOnItemOwnership",
+				RequiredID: templateId,
+				MinQuantity: 1,
+			})
+			entry.ItemGrants = append(entry.ItemGrants, models.ItemGrant{
+				TemplateID: templateId,
+				Quantity: 1,
+			})
+		}
+
+		id := all.HashString(strings.Join(templateIds, ","))
+		entry.OfferID = id
+		entry.DevName = id
+	}
+
+	entry.Title = planEntry.Title
+	entry.Prices[0].RegularPrice = price
+	entry.Prices[0].BasePrice = price
+	entry.Prices[0].FinalPrice = price
+
+	if planEntry.SalePrice > 0 && planEntry.SalePrice < price {
+		saleExpiration := planEntry.SaleExpiration
+		if saleExpiration == "" {
+			saleExpiration = expiration
+		}
+
+		// applied when the catalog is read so the price goes back once the sale ends
+		entry.PlannedSale = &models.PlannedSale{
+			FinalPrice: planEntry.SalePrice,
+			Expiration: saleExpiration,
+		}
+	}
+
+	return entry, nil
+}
+
+func BuildPlannedItemShop(config models.ShopRotationConfig, request models.ShopPlanRequest, day time.Time) (models.StorePage, error) {
+	expiration := shopExpiration(config, day)
+
+	page := models.StorePage{
+		RefreshIntervalHrs: config.RefreshIntervalHrs,
+		DailyPurchaseHrs: config.DailyPurchaseHrs,
+		Expiration: expiration,
+		Storefronts: []models.Storefront{},
+	}
+
+	for _, storefrontPlan := range request.Storefronts {
+		storefront := models.Storefront{
+			Name: storefrontPlan.Name,
+			CatalogEntries: []models.CatalogEntry{},
+		}
+
+		for i, planEntry := range storefrontPlan.Entries {
+			entry, err := NewPlannedCatalogEntry(planEntry, -i - 1, expiration)
+			if err != nil {
+				return models.StorePage{}, err
+			}
+
+			storefront.CatalogEntries = append(storefront.CatalogEntries, entry)
+		}
+
+		page.Storefronts = append(page.Storefronts, storefront)
+	}
+
+	if request.BattlePass {
+		if storefront, ok := GenerateBattlePassStorefront(config.BattlePass); ok {
+			page.Storefronts = append(page.Storefronts, storefront)
+		}
+	}
+
+	return page, nil
+}
+
+func PreviewShopPlan(request models.ShopPlanRequest) (models.StorePage, error) {
+	config, err := GetShopRotationConfig()
+	if err != nil {
+		return models.StorePage{}, err
+	}
+
+	day, err := time.ParseInLocation("2006-01-02", request.Date, time.Local)
+	if err != nil {
+		return models.StorePage{}, ErrShopPlanInvalidDate
+	}
+
+	rollover, _ := ShopRollover(config, day.Add(time.Hour * 24 - time.Nanosecond))
+	return BuildPlannedItemShop(config, request, rollover)
+}
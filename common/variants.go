@@ -0,0 +1,208 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var AllVariants map[string]models.VariantCatalogueEntry
+
+func GetVariantCatalogue() (map[string]models.VariantCatalogueEntry, error) {
+	if AllVariants == nil {
+		pathToVariants := "data/variants.json"
+
+		file, err := os.Open(pathToVariants)
+		if err != nil {
+			return map[string]models.VariantCatalogueEntry{}, err
+		}
+		defer file.Close()
+
+		fileData, err := io.ReadAll(file)
+		if err != nil {
+			return map[string]models.VariantCatalogueEntry{}, err
+		}
+		str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+
+		var entries []models.VariantCatalogueEntry
+		err = json.Unmarshal([]byte(str), &entries)
+		if err != nil {
+			return map[string]models.VariantCatalogueEntry{}, err
+		}
+
+		tempAllVariants := make(map[string]models.VariantCatalogueEntry)
+		for _, entry := range entries {
+			if entry.TemplateId == "" {
+				continue
+			}
+
+			tempAllVariants[strings.ToLower(entry.TemplateId)] = entry
+		}
+		AllVariants = tempAllVariants
+
+		all.PrintGreen([]any{"loaded variants for", len(AllVariants), "cosmetics"})
+	}
+
+	return AllVariants, nil
+}
+
+func GetVariantCatalogueEntry(templateId string) (models.VariantCatalogueEntry, bool) {
+	catalogue, err := GetVariantCatalogue()
+	if err != nil {
+		return models.VariantCatalogueEntry{}, false
+	}
+
+	entry, ok := catalogue[strings.ToLower(templateId)]
+	return entry, ok
+}
+
+func findCatalogueChannel(entry models.VariantCatalogueEntry, channel string) (models.VariantCatalogueChannel, bool) {
+	for _, catalogueChannel := range entry.Channels {
+		if catalogueChannel.Channel == channel {
+			return catalogueChannel, true
+		}
+	}
+
+	return models.VariantCatalogueChannel{}, false
+}
+
+func GetDefaultVariants(templateId string) []models.ItemVariant {
+	variants := []models.ItemVariant{}
+
+	entry, ok := GetVariantCatalogueEntry(templateId)
+	if !ok {
+		return variants
+	}
+
+	for _, channel := range entry.Channels {
+		owned := []string{}
+		for _, tag := range channel.Tags {
+			if tag.DefaultOwned {
+				owned = append(owned, tag.Tag)
+			}
+		}
+
+		if len(owned) == 0 {
+			continue
+		}
+
+		variants = append(variants, models.ItemVariant{
+			Channel: channel.Channel,
+			Active: owned[0],
+			Owned: owned,
+		})
+	}
+
+	return variants
+}
+
+func GetOwnedVariantTags(item *models.Item, channel string) []string {
+	owned := []string{}
+
+	if variant, err := FindVariant(item, channel); err == nil {
+		owned = append(owned, variant.Owned...)
+	}
+
+	for _, variant := range GetDefaultVariants(item.TemplateId) {
+		if variant.Channel == channel {
+			owned = appendMissingTags(owned, variant.Owned)
+		}
+	}
+
+	return owned
+}
+
+func ValidateVariantUpdate(item *models.Item, variant models.ItemVariant) error {
+	entry, ok := GetVariantCatalogueEntry(item.TemplateId)
+	if !ok {
+		return nil
+	}
+
+	channel, ok := findCatalogueChannel(entry, variant.Channel)
+	if !ok {
+		return fmt.Errorf("item %s has no variant channel %s", item.TemplateId, variant.Channel)
+	}
+
+	tagExists := false
+	for _, tag := range channel.Tags {
+		if tag.Tag == variant.Active {
+			tagExists = true
+			break
+		}
+	}
+
+	if !tagExists {
+		return fmt.Errorf("item %s has no variant %s in channel %s", item.TemplateId, variant.Active, variant.Channel)
+	}
+
+	for _, owned := range GetOwnedVariantTags(item, variant.Channel) {
+		if owned == variant.Active {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("variant %s in channel %s is not owned", variant.Active, variant.Channel)
+}
+
+func GrantVariantTags(item *models.Item, channel string, tags []string) error {
+	entry, ok := GetVariantCatalogueEntry(item.TemplateId)
+	if !ok {
+		return fmt.Errorf("item %s has no variants", item.TemplateId)
+	}
+
+	catalogueChannel, ok := findCatalogueChannel(entry, channel)
+	if !ok {
+		return fmt.Errorf("item %s has no variant channel %s", item.TemplateId, channel)
+	}
+
+	for _, wanted := range tags {
+		tagExists := false
+		for _, tag := range catalogueChannel.Tags {
+			if tag.Tag == wanted {
+				tagExists = true
+				break
+			}
+		}
+
+		if !tagExists {
+			return fmt.Errorf("item %s has no variant %s in channel %s", item.TemplateId, wanted, channel)
+		}
+	}
+
+	owned := appendMissingTags(GetOwnedVariantTags(item, channel), tags)
+	variant, err := FindVariant(item, channel)
+	if err != nil {
+		variant = models.ItemVariant{
+			Channel: channel,
+			Active: owned[0],
+		}
+	}
+	variant.Owned = owned
+
+	SetVariantInItem(item, variant)
+	return nil
+}
+
+func appendMissingTags(owned []string, tags []string) []string {
+	for _, tag := range tags {
+		found := false
+		for _, ownedTag := range owned {
+			if ownedTag == tag {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			owned = append(owned, tag)
+		}
+	}
+
+	return owned
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zombman/server/all"
+)
+
+var (
+	WebhookAttempts = 5
+
+	webhookClient = &http.Client{
+		Timeout: time.Second * 10,
+	}
+
+	ErrWebhookRejected = errors.New("webhook was rejected")
+	ErrWebhookUnsigned = errors.New("webhook secret is not set")
+)
+
+func SignWebhook(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(WebhookSecret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyWebhookSignature(body []byte, signature string) bool {
+	if WebhookSecret == "" || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+
+	return hmac.Equal([]byte(SignWebhook(body)), []byte(signature))
+}
+
+func postWebhook(event string, body []byte) error {
+	request, err := http.NewRequest(http.MethodPost, WebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-Webhook-Event", event)
+	request.Header.Set("X-Webhook-Signature", SignWebhook(body))
+
+	response, err := webhookClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	// a 4xx won't get better by sending it again
+	if response.StatusCode >= 400 && response.StatusCode < 500 && response.StatusCode != http.StatusTooManyRequests {
+		return fmt.Errorf("%s returned %d: %w", event, response.StatusCode, ErrWebhookRejected)
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("webhook %s returned %d", event, response.StatusCode)
+	}
+
+	return nil
+}
+
+// the body is built once so every retry carries the same signature and timestamp
+func SendWebhook(event string, data any) error {
+	if WebhookUrl == "" {
+		return nil
+	}
+
+	// receivers have no way to tell an unsigned body from a forged one
+	if WebhookSecret == "" {
+		return ErrWebhookUnsigned
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"event": event,
+		"timestamp": time.Now().Format("2006-01-02T15:04:05.999Z"),
+		"data": data,
+	})
+	if err != nil {
+		return err
+	}
+
+	wait := time.Second
+	for attempt := 1; ; attempt++ {
+		err = postWebhook(event, body)
+		if err == nil || errors.Is(err, ErrWebhookRejected) || attempt >= WebhookAttempts {
+			return err
+		}
+
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// the missing secret is warned about once at startup rather than on every event
+func DispatchWebhook(event string, data any) {
+	if WebhookUrl == "" || WebhookSecret == "" {
+		return
+	}
+
+	go func() {
+		err := SendWebhook(event, data)
+		if err != nil {
+			all.PrintRed([]any{"could not send webhook", event, err.Error()})
+		}
+	}()
+}
@@ -0,0 +1,110 @@
+package common
+
+import (
+	"errors"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var (
+	ErrWishlistUnknownItem = errors.New("wishlist item does not exist")
+	ErrWishlistItemExists = errors.New("item is already on the wishlist")
+	ErrWishlistFull = errors.New("wishlist is full")
+	ErrWishlistItemNotFound = errors.New("item is not on the wishlist")
+)
+
+func GetWishlist(accountId string) []models.WishlistItem {
+	var items []models.WishlistItem
+	all.Postgres.Where("account_id = ?", accountId).Order("created_at").Find(&items)
+
+	return items
+}
+
+func AddWishlistItem(accountId string, templateId string) (models.WishlistItem, error) {
+	item, ok := GetItem(templateId)
+	if !ok {
+		return models.WishlistItem{}, ErrWishlistUnknownItem
+	}
+
+	var count int64
+	all.Postgres.Model(&models.WishlistItem{}).Where("account_id = ?", accountId).Count(&count)
+	if count >= int64(MaxWishlistItems) {
+		return models.WishlistItem{}, ErrWishlistFull
+	}
+
+	wishlistItem := models.WishlistItem{
+		AccountId: accountId,
+		TemplateId: item.BackendType + ":" + item.ID,
+	}
+
+	var existing models.WishlistItem
+	result := all.Postgres.Where("account_id = ? AND template_id = ?", accountId, wishlistItem.TemplateId).First(&existing)
+	if result.RowsAffected != 0 {
+		return models.WishlistItem{}, ErrWishlistItemExists
+	}
+
+	result = all.Postgres.Create(&wishlistItem)
+	if result.Error != nil {
+		return models.WishlistItem{}, result.Error
+	}
+
+	return wishlistItem, nil
+}
+
+func RemoveWishlistItem(accountId string, templateId string) error {
+	if item, ok := GetItem(templateId); ok {
+		templateId = item.BackendType + ":" + item.ID
+	}
+
+	result := all.Postgres.Unscoped().Where("account_id = ? AND template_id = ?", accountId, templateId).Delete(&models.WishlistItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrWishlistItemNotFound
+	}
+
+	return nil
+}
+
+// a wishlisted item is only reported once per shop day, so a reroll or a
+// restart doesn't tell players about the same item twice
+func GetWishlistMatches(page models.StorePage, date string) map[string][]models.WishlistItem {
+	templateIds := []string{}
+	for _, storefront := range page.Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			for _, grant := range entry.ItemGrants {
+				templateIds = append(templateIds, grant.TemplateID)
+			}
+		}
+	}
+
+	matches := map[string][]models.WishlistItem{}
+	if len(templateIds) == 0 {
+		return matches
+	}
+
+	var items []models.WishlistItem
+	all.Postgres.Where("template_id IN ? AND (notified_for IS NULL OR notified_for <> ?)", templateIds, date).Find(&items)
+
+	for _, item := range items {
+		matches[item.AccountId] = append(matches[item.AccountId], item)
+	}
+
+	return matches
+}
+
+func MarkWishlistNotified(items []models.WishlistItem, date string) {
+	ids := []uint{}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	all.Postgres.Model(&models.WishlistItem{}).Where("id IN ?", ids).Update("notified_for", date)
+}
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/models"
+)
+
+var XpCurve *models.XpCurve
+
+func GetXpCurve() models.XpCurve {
+	if XpCurve == nil {
+		curve := models.XpCurve{
+			LevelXp: []int{1000},
+			MaxLevel: 100,
+			TierXp: 1000,
+		}
+
+		file, err := os.Open("data/xp.json")
+		if err == nil {
+			defer file.Close()
+
+			fileData, err := io.ReadAll(file)
+			if err == nil {
+				str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+				if err := json.Unmarshal([]byte(str), &curve); err != nil {
+					all.PrintRed([]any{"could not read xp curve", err.Error()})
+				}
+			}
+		}
+
+		if len(curve.LevelXp) == 0 {
+			curve.LevelXp = []int{1000}
+		}
+
+		if curve.TierXp < 1 {
+			curve.TierXp = 1000
+		}
+
+		XpCurve = &curve
+	}
+
+	return *XpCurve
+}
+
+// levels past the end of the curve keep using the last entry
+func XpForLevel(curve models.XpCurve, level int) int {
+	index := level - 1
+	if index < 0 {
+		index = 0
+	}
+
+	if index >= len(curve.LevelXp) {
+		index = len(curve.LevelXp) - 1
+	}
+
+	if curve.LevelXp[index] < 1 {
+		return 1
+	}
+
+	return curve.LevelXp[index]
+}
+
+func ApplyXpBoosts(profile *models.Profile, xp int, withFriends bool) int {
+	boost, _ := StatToInt(profile.Stats.Attributes["season_match_boost"])
+	if withFriends {
+		friendBoost, _ := StatToInt(profile.Stats.Attributes["season_friend_match_boost"])
+		boost += friendBoost
+	}
+
+	return xp * (100 + boost) / 100
+}
+
+func AddMatchXpToProfile(athenaProfile *models.Profile, commonCore *models.Profile, accountId string, xp int, withFriends bool) models.BattlePassGrantResult {
+	return AddXpToProfile(athenaProfile, commonCore, accountId, ApplyXpBoosts(athenaProfile, xp, withFriends))
+}
+
+func AddXpToProfile(athenaProfile *models.Profile, commonCore *models.Profile, accountId string, earnedXp int) models.BattlePassGrantResult {
+	curve := GetXpCurve()
+
+	level, _ := StatToInt(athenaProfile.Stats.Attributes["level"])
+	currentXp, _ := StatToInt(athenaProfile.Stats.Attributes["xp"])
+	if level < 1 {
+		level = 1
+	}
+
+	currentXp += earnedXp
+	for level < curve.MaxLevel && currentXp >= XpForLevel(curve, level) {
+		currentXp -= XpForLevel(curve, level)
+		level++
+	}
+
+	bookLevel, _ := StatToInt(athenaProfile.Stats.Attributes["book_level"])
+	bookXp, _ := StatToInt(athenaProfile.Stats.Attributes["book_xp"])
+	if bookLevel < 1 {
+		bookLevel = 1
+	}
+	oldBookLevel := bookLevel
+
+	rewards := []models.BattlePassReward{}
+	pass, err := GetBattlePass(Season)
+	if err == nil {
+		bookXp += earnedXp
+		for bookLevel < len(pass.PaidRewards) && bookXp >= curve.TierXp {
+			bookXp -= curve.TierXp
+			bookLevel++
+		}
+
+		// the last tier has nothing left to earn towards
+		if bookLevel >= len(pass.PaidRewards) {
+			bookXp = 0
+		}
+
+		bookPurchased, _ := athenaProfile.Stats.Attributes["book_purchased"].(bool)
+		rewards = GetBattlePassRewards(pass, oldBookLevel + 1, bookLevel, true, bookPurchased)
+	}
+
+	result := GrantBattlePassRewards(athenaProfile, commonCore, rewards, accountId)
+
+	athenaProfile.Stats.Attributes["level"] = level
+	athenaProfile.Stats.Attributes["xp"] = currentXp
+	athenaProfile.Stats.Attributes["book_level"] = bookLevel
+	athenaProfile.Stats.Attributes["book_xp"] = bookXp
+
+	for _, stat := range []string{"level", "xp", "book_level", "book_xp"} {
+		result.AthenaChanges = append(result.AthenaChanges, models.ProfileChange{
+			ChangeType: "statModified",
+			Name: stat,
+			Value: athenaProfile.Stats.Attributes[stat],
+		})
+	}
+
+	all.PrintGreen([]any{accountId, "earned", earnedXp, "xp, now level", level, "tier", bookLevel})
+
+	return result
+}
@@ -0,0 +1,36 @@
+package controllers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+)
+
+func SetAffiliateName(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "common_core" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		AffiliateName string `json:"affiliateName"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	changes, err := common.SetProfileAffiliate(profile, body.AffiliateName)
+	if err != nil {
+		all.PrintRed([]any{"could not set affiliate", body.AffiliateName, err.Error()})
+		common.ErrorInvalidAffiliate(c)
+		return
+	}
+
+	response.ProfileChanges = append(response.ProfileChanges, changes...)
+}
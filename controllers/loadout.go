@@ -0,0 +1,388 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+)
+
+func CopyCosmeticLoadout(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		SourceIndex int `json:"sourceIndex"`
+		TargetIndex int `json:"targetIndex"`
+		OptNewNameForTarget string `json:"optNewNameForTarget"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if err := common.ValidateLockerName(body.OptNewNameForTarget); err != nil {
+		all.PrintRed([]any{err.Error(), body.OptNewNameForTarget})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	common.AppendLoadoutsToProfileNoSave(profile, user.AccountId)
+	loadoutIds := common.GetLoadoutIdsFromProfile(profile)
+
+	if body.SourceIndex < 0 || body.SourceIndex >= len(loadoutIds) || body.TargetIndex < 0 || body.TargetIndex > len(loadoutIds) || body.SourceIndex == body.TargetIndex {
+		all.PrintRed([]any{"invalid loadout index", body.SourceIndex, body.TargetIndex})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	sourceLoadout, err := common.GetLoadout(loadoutIds[body.SourceIndex], user.AccountId)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		c.Abort()
+		return
+	}
+
+	targetLoadout, err := common.CopyLoadout(sourceLoadout)
+	if err != nil {
+		common.ErrorInternalServer(c)
+		c.Abort()
+		return
+	}
+
+	if body.TargetIndex == len(loadoutIds) {
+		targetLoadoutId := uuid.New().String()
+		targetLoadout.Attributes.LockerName = body.OptNewNameForTarget
+
+		if err := common.SaveNewLoadoutForUser(user.AccountId, targetLoadoutId, targetLoadout); err != nil {
+			all.PrintRed([]any{"could not create loadout preset", err.Error()})
+			common.ErrorBadRequest(c)
+			c.Abort()
+			return
+		}
+
+		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+			ChangeType: "itemAdded",
+			ItemID: targetLoadoutId,
+			Item: targetLoadout,
+		})
+	} else {
+		targetLoadoutId := loadoutIds[body.TargetIndex]
+		existingLoadout, err := common.GetLoadout(targetLoadoutId, user.AccountId)
+		if err != nil {
+			common.ErrorItemNotFound(c)
+			c.Abort()
+			return
+		}
+
+		targetLoadout.Attributes.LockerName = existingLoadout.Attributes.LockerName
+		if body.OptNewNameForTarget != "" {
+			targetLoadout.Attributes.LockerName = body.OptNewNameForTarget
+		}
+
+		common.AppendLoadoutToProfileNoSave(profile, targetLoadoutId, &targetLoadout, user.AccountId)
+
+		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+			ChangeType: "itemAttrChanged",
+			ItemID: targetLoadoutId,
+			AttributeName: "locker_slots_data",
+			AttributeValue: targetLoadout.Attributes.LockerSlotsData,
+		})
+
+		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+			ChangeType: "itemAttrChanged",
+			ItemID: targetLoadoutId,
+			AttributeName: "locker_name",
+			AttributeValue: targetLoadout.Attributes.LockerName,
+		})
+	}
+
+	// copying into the locker applies a preset, copying anywhere else saves one
+	lockerIndex := common.LockerLoadoutIndex(loadoutIds)
+	activeLoadoutIndex := body.TargetIndex
+	if body.TargetIndex == lockerIndex {
+		activeLoadoutIndex = body.SourceIndex
+	}
+
+	profile.Stats.Attributes["active_loadout_index"] = activeLoadoutIndex
+	common.AppendLoadoutsToProfileNoSave(profile, user.AccountId)
+
+	if body.TargetIndex == lockerIndex {
+		common.ApplyLoadoutToFavorites(profile, targetLoadout)
+	}
+
+	appendLoadoutStatChanges(profile, response)
+}
+
+func DeleteCosmeticLoadout(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		Index int `json:"index"`
+		FallbackLoadoutIndex int `json:"fallbackLoadoutIndex"`
+		LeaveNullSlot bool `json:"leaveNullSlot"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	common.AppendLoadoutsToProfileNoSave(profile, user.AccountId)
+	loadoutIds := common.GetLoadoutIdsFromProfile(profile)
+
+	// the sandbox and locker loadouts can never be removed, only presets
+	if body.Index < 0 || body.Index >= len(loadoutIds) || common.IsBuiltInLoadout(loadoutIds[body.Index]) {
+		all.PrintRed([]any{"invalid loadout index", body.Index})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	activeLoadoutIndex, _ := common.StatToInt(profile.Stats.Attributes["active_loadout_index"])
+	if activeLoadoutIndex == body.Index {
+		activeLoadoutIndex = body.FallbackLoadoutIndex
+		if activeLoadoutIndex < 0 || activeLoadoutIndex >= len(loadoutIds) || activeLoadoutIndex == body.Index {
+			activeLoadoutIndex = common.LockerLoadoutIndex(loadoutIds)
+		}
+	}
+
+	if activeLoadoutIndex > body.Index {
+		activeLoadoutIndex -= 1
+	}
+
+	deletedLoadoutId := loadoutIds[body.Index]
+	if err := common.DeleteLoadoutForUser(profile, deletedLoadoutId, user.AccountId); err != nil {
+		all.PrintRed([]any{"could not delete loadout", err.Error()})
+		common.ErrorItemNotFound(c)
+		c.Abort()
+		return
+	}
+
+	// loadout order comes from the database, so null slots are never kept
+	profile.Stats.Attributes["active_loadout_index"] = activeLoadoutIndex
+	common.AppendLoadoutsToProfileNoSave(profile, user.AccountId)
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "itemRemoved",
+		ItemID: deletedLoadoutId,
+	})
+
+	appendLoadoutStatChanges(profile, response)
+}
+
+func SetCosmeticLockerName(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		LockerItem string `json:"lockerItem"`
+		Name string `json:"name"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if err := common.ValidateLockerName(body.Name); err != nil {
+		all.PrintRed([]any{err.Error(), body.Name})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	loadout, err := common.GetLoadout(body.LockerItem, user.AccountId)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		c.Abort()
+		return
+	}
+
+	loadout.Attributes.LockerName = body.Name
+	common.AppendLoadoutToProfileNoSave(profile, body.LockerItem, &loadout, user.AccountId)
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "itemAttrChanged",
+		ItemID: body.LockerItem,
+		AttributeName: "locker_name",
+		AttributeValue: body.Name,
+	})
+}
+
+func PutModularCosmeticLoadout(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		LoadoutType string `json:"loadoutType"`
+		PresetId int `json:"presetId"`
+		LoadoutData string `json:"loadoutData"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if !strings.HasPrefix(body.LoadoutType, "CosmeticLoadout:") || body.PresetId < 0 || body.PresetId >= common.MaxLoadoutPresets {
+		all.PrintRed([]any{"invalid modular loadout", body.LoadoutType, body.PresetId})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var loadoutData map[string]any
+	if err := json.Unmarshal([]byte(body.LoadoutData), &loadoutData); err != nil {
+		all.PrintRed([]any{"could not parse loadout data", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	presets := common.GetModularLoadoutPresets(profile)
+	if presets[body.LoadoutType] == nil {
+		presets[body.LoadoutType] = map[string]string{}
+	}
+
+	presetKey := fmt.Sprint(body.PresetId)
+	loadoutItemId, exists := presets[body.LoadoutType][presetKey]
+	if !exists {
+		loadoutItemId = uuid.New().String()
+		presets[body.LoadoutType][presetKey] = loadoutItemId
+	}
+
+	loadoutItem := models.CommonCoreItem{
+		TemplateId: body.LoadoutType,
+		Attributes: loadoutData,
+		Quantity: 1,
+	}
+	profile.Items[loadoutItemId] = loadoutItem
+	profile.Stats.Attributes["loadout_presets"] = presets
+
+	if exists {
+		for attributeName, attributeValue := range loadoutData {
+			response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+				ChangeType: "itemAttrChanged",
+				ItemID: loadoutItemId,
+				AttributeName: attributeName,
+				AttributeValue: attributeValue,
+			})
+		}
+	} else {
+		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+			ChangeType: "itemAdded",
+			ItemID: loadoutItemId,
+			Item: loadoutItem,
+		})
+	}
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "loadout_presets",
+		Value: presets,
+	})
+}
+
+func SetActiveArchetype(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		ArchetypeGroup string `json:"archetypeGroup"`
+		Archetype string `json:"archetype"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil || body.ArchetypeGroup == "" {
+		all.PrintRed([]any{"could not bind body", body.ArchetypeGroup})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	archetypes := map[string]string{}
+	if marshal, err := json.Marshal(profile.Stats.Attributes["loadout_archetype_values"]); err == nil {
+		json.Unmarshal(marshal, &archetypes)
+	}
+	if archetypes == nil {
+		archetypes = map[string]string{}
+	}
+
+	archetypes[body.ArchetypeGroup] = body.Archetype
+	profile.Stats.Attributes["loadout_archetype_values"] = archetypes
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "loadout_archetype_values",
+		Value: archetypes,
+	})
+}
+
+func SetRandomCosmeticLoadoutFlag(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		Random bool `json:"random"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	profile.Stats.Attributes["use_random_loadout"] = body.Random
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "use_random_loadout",
+		Value: body.Random,
+	})
+}
+
+func appendLoadoutStatChanges(profile *models.Profile, response *models.ProfileResponse) {
+	for _, statName := range []string{"loadouts", "active_loadout_index", "last_applied_loadout"} {
+		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+			ChangeType: "statModified",
+			Name: statName,
+			Value: profile.Stats.Attributes[statName],
+		})
+	}
+}
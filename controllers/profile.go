@@ -1,7 +1,6 @@
 package controllers
 
 import (
-	"encoding/json"
 	"os"
 	"strconv"
 	"strings"
@@ -44,6 +43,30 @@ func ClientProfileActionHandler(c *gin.Context) {
 			GiftCatalogEntry(c, user, &profile, &response)
 		case "RemoveGiftBox":
 			RemoveGiftBox(c, user, &profile, &response)
+		case "SetReceiveGiftsEnabled":
+			SetReceiveGiftsEnabled(c, user, &profile, &response)
+		case "RefundMtxPurchase":
+			RefundMtxPurchase(c, user, &profile, &response)
+		case "SetAffiliateName":
+			SetAffiliateName(c, user, &profile, &response)
+		case "CopyCosmeticLoadout":
+			CopyCosmeticLoadout(c, user, &profile, &response)
+		case "DeleteCosmeticLoadout":
+			DeleteCosmeticLoadout(c, user, &profile, &response)
+		case "SetCosmeticLockerName":
+			SetCosmeticLockerName(c, user, &profile, &response)
+		case "PutModularCosmeticLoadout":
+			PutModularCosmeticLoadout(c, user, &profile, &response)
+		case "SetActiveArchetype":
+			SetActiveArchetype(c, user, &profile, &response)
+		case "SetRandomCosmeticLoadoutFlag":
+			SetRandomCosmeticLoadoutFlag(c, user, &profile, &response)
+		case "ClientQuestLogin":
+			ClientQuestLogin(c, user, &profile, &response)
+		case "FortRerollDailyQuest":
+			FortRerollDailyQuest(c, user, &profile, &response)
+		case "MarkNewQuestNotificationSent":
+			MarkNewQuestNotificationSent(c, user, &profile, &response)
 		default:
 			break
 	}
@@ -115,6 +138,7 @@ func DedicatedServerProfileActionHandler(c *gin.Context) {
 
 	if profile.ProfileId == "athena" {
 		profile.Stats.Attributes["season_num"] = common.Season
+		common.ResolveRandomCosmetics(&profile, userId)
 	}
 	
 	response.ProfileChanges = []models.ProfileChange{{
@@ -163,6 +187,8 @@ func QueryProfile(c *gin.Context, user models.User, profile *models.Profile, res
 			return
 		}
 
+		common.RefreshQuests(profile)
+
 		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 			ChangeType: "itemAttrChanged",
 			ItemID: "Default:CosmeticLocker",
@@ -172,15 +198,20 @@ func QueryProfile(c *gin.Context, user models.User, profile *models.Profile, res
 	}
 
 	if profile.ProfileId == "common_core" {
-		profile.Stats.Attributes["allowed_to_receive_gifts"] = true
-		profile.Stats.Attributes["allowed_to_send_gifts"] = true
+		// players can turn gifts off, so only fill these in for profiles that never had them
+		for _, stat := range []string{"allowed_to_receive_gifts", "allowed_to_send_gifts"} {
+			if _, ok := profile.Stats.Attributes[stat]; !ok {
+				profile.Stats.Attributes[stat] = true
+			}
+		}
 		profile.Stats.Attributes["mfa_enabled"] = true
+		common.SyncVBucksItem(user.AccountId, profile)
 	}
 
 	if profile.ProfileId == "common_core" {
 		if user.LastLogon == "" {
 			user.LastLogon = time.Now().AddDate(0, 0, -1).Format("2006-01-02T15:04:05.999Z")
-			all.Postgres.Save(&user)
+			all.Postgres.Model(&models.User{}).Where("account_id = ?", user.AccountId).Update("last_logon", user.LastLogon)
 		}
 
 		timeLastLoggedOn, err := time.Parse("2006-01-02T15:04:05.999Z", user.LastLogon)
@@ -203,33 +234,18 @@ func QueryProfile(c *gin.Context, user models.User, profile *models.Profile, res
 		}
 
 		if timeLastLoggedOn.Day() != timeNow.Day() {
-			user.VBucks += dailyVBucks
 			user.LastLogon = doday
-			all.Postgres.Save(&user)
+			all.Postgres.Model(&models.User{}).Where("account_id = ?", user.AccountId).Update("last_logon", user.LastLogon)
 
-			common.AddUserVBucks(user.AccountId, profile, dailyVBucks)
+			common.AddUserVBucks(user.AccountId, profile, dailyVBucks, common.VBucksReasonDaily, doday)
 			common.AppendLoadoutsToProfile(profile, user.AccountId)
 
-			gift := models.CommonCoreItem{
-				TemplateId: "GiftBox:gb_default",
-				Attributes: gin.H{
-					"fromAccountId": "Server",
-					"lootList": []gin.H{{
-						"itemType": "Currency:MtxGiveaway",
-						"itemGuid": "Currency:MtxGiveaway",
-						"itemProfile": "athena",
-						"quantity": dailyVBucks,
-					}},
-					"params": gin.H{
-						"userMessage": "Daily Login Reward",
-					},
-					"level": 1,
-					"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-				},
-				Quantity: 1,
-			}
-			profile.Items["GiftBox:gb_default"] = gift
-			profile.Stats.Attributes["gift_history"] = append(profile.Stats.Attributes["gift_history"].([]interface{}), gift)
+			common.AddGiftBoxToProfile(profile, common.NewGiftBox("", "Server", []models.LootResultItem{{
+				ItemType: "Currency:MtxGiveaway",
+				ItemGuid: "Currency:MtxGiveaway",
+				ItemProfile: "athena",
+				Quantity: dailyVBucks,
+			}}, "Daily Login Reward"))
 			common.SaveProfileToUser(user.AccountId, *profile)
 
 			all.PrintGreen([]any{"giving daily login reward", user.Username})
@@ -266,7 +282,7 @@ func PurchaseCatalogEntry(c *gin.Context, user models.User, profile *models.Prof
 		return
 	}
 
-	if strings.Contains(body.OfferId, "battlepass") {
+	if common.IsBattlePassOffer(body.OfferId) {
 		PurchaseCatalogEntryBattlePass(c, user, profile, response, body)
 		return
 	}
@@ -279,48 +295,30 @@ func PurchaseCatalogEntry(c *gin.Context, user models.User, profile *models.Prof
 		return
 	}
 
-	playerHasItem := false
-	for _, item := range profile.Items {
-		var marshItem models.Item
-		marshalItem, err := json.Marshal(item)
-		if err != nil {
-			all.PrintRed([]any{"could not marshal item", item})
-			common.ErrorBadRequest(c)
-			c.Abort()
-			return
-		}
-		err = json.Unmarshal(marshalItem, &marshItem)
-		if err != nil {
-			all.PrintRed([]any{"could not unmarshal item", item})
-			common.ErrorBadRequest(c)
-			c.Abort()
-			return
-		}
-
-		for _, grant := range offer.ItemGrants {
-			if marshItem.TemplateId == grant.TemplateID {
-				playerHasItem = true
-				break
-			}
-		}
+	if err := common.CheckCatalogEntryPurchase(offer, &athenaProfile, profile, 1); err != nil {
+		all.PrintRed([]any{"player can not purchase offer", body.OfferId, err.Error()})
+		common.ErrorCatalogRequirement(c, err)
+		return
 	}
 
-	if playerHasItem {
-		all.PrintRed([]any{"player already has item", offer.ItemGrants[0].TemplateID})
+	price := common.GetOfferPrice(offer, &athenaProfile)
+	if price != body.ExpectedTotalPrice {
+		all.PrintRed([]any{"expected price does not match", price, body.ExpectedTotalPrice})
 		common.ErrorBadRequest(c)
 		c.Abort()
 		return
 	}
 
-	if offer.Prices[0].FinalPrice != body.ExpectedTotalPrice {
-		all.PrintRed([]any{"expected price does not match", offer.Prices[0].FinalPrice, body.ExpectedTotalPrice})
+	if balance := common.GetVBucksBalance(user.AccountId); balance < price {
+		all.PrintRed([]any{"player does not have enough vbucks", balance, price})
 		common.ErrorBadRequest(c)
 		c.Abort()
 		return
 	}
 
-	if user.VBucks < offer.Prices[0].FinalPrice {
-		all.PrintRed([]any{"player does not have enough vbucks", user.VBucks, offer.Prices[0].FinalPrice})
+	balance, err := common.TakeUserVBucks(user.AccountId, profile, price, common.VBucksReasonPurchase, body.OfferId)
+	if err != nil {
+		all.PrintRed([]any{"could not take vbucks", err.Error()})
 		common.ErrorBadRequest(c)
 		c.Abort()
 		return
@@ -330,8 +328,10 @@ func PurchaseCatalogEntry(c *gin.Context, user models.User, profile *models.Prof
 	lootItems := []models.LootResultItem{}
 
 	for _, grant := range offer.ItemGrants {
-		common.AddItemToProfile(profile, grant.TemplateID, user.AccountId)
-		common.SaveProfileToUser(user.AccountId, *profile)
+		if _, ok := athenaProfile.Items[grant.TemplateID]; ok {
+			continue
+		}
+		common.AddItemToProfile(&athenaProfile, grant.TemplateID, user.AccountId)
 
 		lootItems = append(lootItems, models.LootResultItem{
 			ItemType: grant.TemplateID,
@@ -343,18 +343,11 @@ func PurchaseCatalogEntry(c *gin.Context, user models.User, profile *models.Prof
 		itemsProfileChange = append(itemsProfileChange, models.ProfileChange{
 			ChangeType: "itemAdded",
 			ItemID: grant.TemplateID,
-			Item: models.Item{
-				TemplateId: grant.TemplateID,
-				Attributes: models.ItemAttributes{
-					ItemSeen: false,
-					Variants: []models.ItemVariant{},
-				},
-				Quantity: 1,
-			},
+			Item: athenaProfile.Items[grant.TemplateID],
 		})
 	}
 
-	common.TakeUserVBucks(user.AccountId, profile, offer.Prices[0].FinalPrice)
+	common.RecordMtxPurchase(user.AccountId, profile, offer, price, 1, lootItems)
 
 	athenaProfile.Stats.Attributes["season_num"] = common.Season
 	athenaProfile.Rvn += 1
@@ -366,7 +359,11 @@ func PurchaseCatalogEntry(c *gin.Context, user models.User, profile *models.Prof
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 		ChangeType: "itemQuantityChanged",
 		ItemID: "Currency:MtxPurchased",
-		Quantity: user.VBucks - offer.Prices[0].FinalPrice,
+		Quantity: balance,
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "mtx_purchase_history",
+		Value: profile.Stats.Attributes["mtx_purchase_history"],
 	})
 	
 	response.MultiUpdate = append(response.MultiUpdate, models.MultiUpdate{
@@ -394,18 +391,230 @@ func PurchaseCatalogEntryBattlePass(c *gin.Context, user models.User, profile *m
 		return
 	}
 
-	all.PrintGreen([]any{"purchasing battle pass"})
+	pass, err := common.GetBattlePass(common.Season)
+	if err != nil {
+		all.PrintRed([]any{"could not read battle pass for season", common.Season, err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
 
-	if user.VBucks < offer.Prices[0].FinalPrice {
+	if body.OfferId == pass.TierOfferId {
+		PurchaseCatalogEntryBattlePassTiers(c, user, profile, response, body, offer, pass)
+		return
+	}
+
+	if body.OfferId != pass.BattlePassOfferId && body.OfferId != pass.BattleBundleOfferId {
+		all.PrintRed([]any{"offer is not a battle pass offer", body.OfferId})
 		common.ErrorBadRequest(c)
 		c.Abort()
 		return
 	}
 
+	all.PrintGreen([]any{"purchasing battle pass", body.OfferId})
+
+	price := offer.Prices[0].FinalPrice
+	if price != body.ExpectedTotalPrice {
+		all.PrintRed([]any{"expected price does not match", price, body.ExpectedTotalPrice})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if balance := common.GetVBucksBalance(user.AccountId); balance < price {
+		all.PrintRed([]any{"player does not have enough vbucks", balance, price})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	athenaProfile, err := common.ReadProfileFromUser(user.AccountId, "athena")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if bookPurchased, _ := athenaProfile.Stats.Attributes["book_purchased"].(bool); bookPurchased {
+		all.PrintRed([]any{"player already owns the battle pass", user.AccountId})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if err := common.CheckCatalogEntryPurchase(offer, &athenaProfile, profile, 1); err != nil {
+		all.PrintRed([]any{"player can not purchase offer", body.OfferId, err.Error()})
+		common.ErrorCatalogRequirement(c, err)
+		return
+	}
+
+	oldBookLevel, _ := common.StatToInt(athenaProfile.Stats.Attributes["book_level"])
+	oldBookLevel = common.CapBattlePassLevel(pass, oldBookLevel)
+	newBookLevel := oldBookLevel
+	if body.OfferId == pass.BattleBundleOfferId {
+		newBookLevel = common.CapBattlePassLevel(pass, oldBookLevel + common.BattleBundleTiers)
+	}
+
+	rewards := common.GetBattlePassRewards(pass, 1, oldBookLevel, false, true)
+	rewards = append(rewards, common.GetBattlePassRewards(pass, oldBookLevel + 1, newBookLevel, true, true)...)
+
+	balance, err := common.TakeUserVBucks(user.AccountId, profile, price, common.VBucksReasonPurchase, body.OfferId)
+	if err != nil {
+		all.PrintRed([]any{"could not take vbucks", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+	granted := common.GrantBattlePassRewards(&athenaProfile, profile, rewards, user.AccountId)
+	common.RecordMtxPurchase(user.AccountId, profile, offer, price, 1, granted.LootItems)
+
+	athenaProfile.Stats.Attributes["book_purchased"] = true
+	athenaProfile.Stats.Attributes["book_level"] = newBookLevel
+	athenaProfile.Stats.Attributes["season_num"] = common.Season
+	athenaProfile.Rvn += 1
+	athenaProfile.CommandRevision = athenaProfile.Rvn
+	athenaProfile.AccountId = user.AccountId
+	athenaProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+	common.SaveProfileToUser(user.AccountId, athenaProfile)
+
+	athenaChanges := append(granted.AthenaChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "book_purchased",
+		Value: true,
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "book_level",
+		Value: newBookLevel,
+	})
+
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 		ChangeType: "itemQuantityChanged",
 		ItemID: "Currency:MtxPurchased",
-		Quantity: user.VBucks - offer.Prices[0].FinalPrice,
+		Quantity: balance + granted.VBucks,
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "mtx_purchase_history",
+		Value: profile.Stats.Attributes["mtx_purchase_history"],
+	})
+	response.ProfileChanges = append(response.ProfileChanges, granted.CommonCoreChanges...)
+
+	response.MultiUpdate = append(response.MultiUpdate, models.MultiUpdate{
+		ProfileRevision: athenaProfile.Rvn,
+		ProfileCommandRevision: athenaProfile.CommandRevision,
+		ProfileID: "athena",
+		ProfileChangesBaseRevision: athenaProfile.Rvn - 1,
+		ProfileChanges: athenaChanges,
+	})
+
+	response.Notifications = append(response.Notifications, models.Notification{
+		Type: "CatalogPurchase",
+		Primary: true,
+		LootResult: models.LootResult{
+			Items: granted.LootItems,
+		},
+	})
+}
+
+func PurchaseCatalogEntryBattlePassTiers(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse, body CatalogOffer, offer models.CatalogEntry, pass models.BattlePass) {
+	quantity := body.PurchaseQuantity
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	tierPrice := offer.Prices[0].FinalPrice
+	if tierPrice * quantity != body.ExpectedTotalPrice {
+		all.PrintRed([]any{"expected price does not match", tierPrice * quantity, body.ExpectedTotalPrice})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	athenaProfile, err := common.ReadProfileFromUser(user.AccountId, "athena")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	if err := common.CheckCatalogEntryPurchase(offer, &athenaProfile, profile, quantity); err != nil {
+		all.PrintRed([]any{"player can not purchase offer", body.OfferId, err.Error()})
+		common.ErrorCatalogRequirement(c, err)
+		return
+	}
+
+	oldBookLevel, _ := common.StatToInt(athenaProfile.Stats.Attributes["book_level"])
+	oldBookLevel = common.CapBattlePassLevel(pass, oldBookLevel)
+	newBookLevel := common.CapBattlePassLevel(pass, oldBookLevel + quantity)
+	if newBookLevel == oldBookLevel {
+		all.PrintRed([]any{"player is already at the last tier", user.AccountId})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	// only charge for the tiers that are left
+	price := tierPrice * (newBookLevel - oldBookLevel)
+	if balance := common.GetVBucksBalance(user.AccountId); balance < price {
+		all.PrintRed([]any{"player does not have enough vbucks", balance, price})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	all.PrintGreen([]any{"purchasing", newBookLevel - oldBookLevel, "battle pass tiers"})
+
+	bookPurchased, _ := athenaProfile.Stats.Attributes["book_purchased"].(bool)
+	rewards := common.GetBattlePassRewards(pass, oldBookLevel + 1, newBookLevel, true, bookPurchased)
+
+	balance, err := common.TakeUserVBucks(user.AccountId, profile, price, common.VBucksReasonPurchase, body.OfferId)
+	if err != nil {
+		all.PrintRed([]any{"could not take vbucks", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+	granted := common.GrantBattlePassRewards(&athenaProfile, profile, rewards, user.AccountId)
+	common.RecordMtxPurchase(user.AccountId, profile, offer, price, newBookLevel - oldBookLevel, granted.LootItems)
+
+	athenaProfile.Stats.Attributes["book_level"] = newBookLevel
+	athenaProfile.Stats.Attributes["season_num"] = common.Season
+	athenaProfile.Rvn += 1
+	athenaProfile.CommandRevision = athenaProfile.Rvn
+	athenaProfile.AccountId = user.AccountId
+	athenaProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+	common.SaveProfileToUser(user.AccountId, athenaProfile)
+
+	athenaChanges := append(granted.AthenaChanges, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "book_level",
+		Value: newBookLevel,
+	})
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "itemQuantityChanged",
+		ItemID: "Currency:MtxPurchased",
+		Quantity: balance + granted.VBucks,
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "mtx_purchase_history",
+		Value: profile.Stats.Attributes["mtx_purchase_history"],
+	})
+	response.ProfileChanges = append(response.ProfileChanges, granted.CommonCoreChanges...)
+
+	response.MultiUpdate = append(response.MultiUpdate, models.MultiUpdate{
+		ProfileRevision: athenaProfile.Rvn,
+		ProfileCommandRevision: athenaProfile.CommandRevision,
+		ProfileID: "athena",
+		ProfileChangesBaseRevision: athenaProfile.Rvn - 1,
+		ProfileChanges: athenaChanges,
+	})
+
+	response.Notifications = append(response.Notifications, models.Notification{
+		Type: "CatalogPurchase",
+		Primary: true,
+		LootResult: models.LootResult{
+			Items: granted.LootItems,
+		},
 	})
 }
 
@@ -448,6 +657,17 @@ func EquipBattleRoyaleCustomization(c *gin.Context, user models.User, profile *m
 	lowercaseItemType := strings.ToLower(body.SlotName)
 	var valueChanged any
 
+	if common.IsRandomItem(body.ItemToSlot) {
+		if !common.IsRandomItemForSlot(body.ItemToSlot, lowercaseItemType) {
+			all.PrintRed([]any{"random item does not match slot", body.ItemToSlot, body.SlotName})
+			common.ErrorBadRequest(c)
+			c.Abort()
+			return
+		}
+
+		body.VariantUpdates = []models.ItemVariant{}
+	}
+
 	switch lowercaseItemType {
 		case "character":
 			athenaProfile.Stats.Attributes.FavoriteCharacter = body.ItemToSlot
@@ -527,17 +747,22 @@ func EquipBattleRoyaleCustomization(c *gin.Context, user models.User, profile *m
 			return
 		}
 
+		if err := common.ValidateVariantUpdate(&itemWithVariant, variant); err != nil {
+			all.PrintRed([]any{"invalid variant update", err.Error()})
+			common.ErrorBadRequest(c)
+			c.Abort()
+			return
+		}
+
 		variantFound, err := common.FindVariant(&itemWithVariant, variant.Channel)
 		if err != nil {
 			variantFound = models.ItemVariant{
 				Channel: variant.Channel,
-				Active: variant.Active,
-				Owned: variant.Owned,
+				Owned: []string{},
 			}
 		}
 
 		variantFound.Active = variant.Active
-		variantFound.Owned = []string{variant.Active}
 		common.SetVariantInItem(&itemWithVariant, variantFound)
 		profile.Items[body.ItemToSlot] = itemWithVariant
 
@@ -550,7 +775,7 @@ func EquipBattleRoyaleCustomization(c *gin.Context, user models.User, profile *m
 	}
 
 	profile.Stats.Attributes["last_applied_loadout"] = activeLoadoutId
-	common.AppendLoadoutToProfileNoSave(profile, &activeLoadout, user.AccountId)
+	common.AppendLoadoutToProfileNoSave(profile, activeLoadoutId, &activeLoadout, user.AccountId)
 }
 
 func SetBattleRoyaleBanner(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
@@ -597,7 +822,7 @@ func SetBattleRoyaleBanner(c *gin.Context, user models.User, profile *models.Pro
 	profile.Items = defaultProfile.Items
 	profile.Stats = defaultProfile.Stats
 
-	common.AppendLoadoutToProfileNoSave(profile, &activeLoadout, user.AccountId)
+	common.AppendLoadoutToProfileNoSave(profile, activeLoadoutId, &activeLoadout, user.AccountId)
 
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 		ChangeType: "statModified",
@@ -647,7 +872,7 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 		return
 	}
 
-	sandboxLoadout, err := common.GetLoadout("sandbox_loadout", user.AccountId)
+	sandboxLoadout, err := common.GetLoadout(common.SandboxLoadoutId, user.AccountId)
 	if err != nil {
 		all.PrintRed([]any{err.Error()})
 		response.ProfileRevision = -37707
@@ -657,6 +882,19 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 	}
 	
 	lowercaseItemType := strings.ToLower(body.Category)
+
+	if common.IsRandomItem(body.ItemToSlot) {
+		if !common.IsRandomItemForSlot(body.ItemToSlot, lowercaseItemType) {
+			all.PrintRed([]any{"random item does not match slot", body.ItemToSlot, body.Category})
+			response.ProfileRevision = -37707
+			common.ErrorBadRequest(c)
+			c.Abort()
+			return
+		}
+
+		body.VariantUpdates = []models.ItemVariant{}
+	}
+
 	switch lowercaseItemType {
 		case "character":
 			athenaProfile.Stats.Attributes.FavoriteCharacter = body.ItemToSlot
@@ -722,6 +960,9 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 		return
 	}
 
+	profile.Items = defaultProfile.Items
+	profile.Stats = defaultProfile.Stats
+
 	for _, variant := range body.VariantUpdates {
 		itemWithVariant, err := common.GetItemFromProfile(profile, body.ItemToSlot)
 		if err != nil {
@@ -731,25 +972,31 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 			return
 		}
 
+		if err := common.ValidateVariantUpdate(&itemWithVariant, variant); err != nil {
+			response.ProfileRevision = -37707
+			all.PrintRed([]any{"invalid variant update", err.Error()})
+			common.ErrorBadRequest(c)
+			c.Abort()
+			return
+		}
+
 		variantFound, err := common.FindVariant(&itemWithVariant, variant.Channel)
 		if err != nil {
 			variantFound = models.ItemVariant{
 				Channel: variant.Channel,
-				Active: variant.Active,
-				Owned: variant.Owned,
+				Owned: []string{},
 			}
 		}
 
 		variantFound.Active = variant.Active
-		variantFound.Owned = []string{variant.Active}
+		common.SetVariantInItem(&itemWithVariant, variantFound)
 
 		itemSlot := activeLoadout.Attributes.LockerSlotsData.Slots[body.Category]
-		for _, variant := range itemSlot.ActiveVariants {
-			variant.Variants = itemWithVariant.Attributes.Variants
-		} 
+		for i := range itemSlot.ActiveVariants {
+			itemSlot.ActiveVariants[i].Variants = itemWithVariant.Attributes.Variants
+		}
 		activeLoadout.Attributes.LockerSlotsData.Slots[body.Category] = itemSlot
 
-		common.SetVariantInItem(&itemWithVariant, variantFound)
 		profile.Items[body.ItemToSlot] = itemWithVariant
 
 		response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
@@ -760,9 +1007,6 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 		})
 	}
 
-	profile.Items = defaultProfile.Items
-	profile.Stats = defaultProfile.Stats
-
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 		ChangeType: "itemAttrChanged",
 		ItemID: body.LockerItem,
@@ -773,8 +1017,8 @@ func SetCosmeticLockerSlot(c *gin.Context, user models.User, profile *models.Pro
 	all.PrintCyan([]any{response.ProfileChanges})
 
 	profile.Stats.Attributes["LastAppliedLoadout"] = activeLoadoutId
-	common.AppendLoadoutToProfileNoSave(profile, &activeLoadout, user.AccountId)
-	common.AppendLoadoutToProfileNoSave(profile, &sandboxLoadout, user.AccountId)
+	common.AppendLoadoutToProfileNoSave(profile, activeLoadoutId, &activeLoadout, user.AccountId)
+	common.AppendLoadoutToProfileNoSave(profile, common.SandboxLoadoutId, &sandboxLoadout, user.AccountId)
 }
 
 func SetCosmeticLockerBanner(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
@@ -822,7 +1066,7 @@ func SetCosmeticLockerBanner(c *gin.Context, user models.User, profile *models.P
 	profile.Items = defaultProfile.Items
 	profile.Stats = defaultProfile.Stats
 
-	common.AppendLoadoutToProfileNoSave(profile, &activeLoadout, user.AccountId)
+	common.AppendLoadoutToProfileNoSave(profile, activeLoadoutId, &activeLoadout, user.AccountId)
 
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
 		ChangeType: "itemAttrChanged",
@@ -862,97 +1106,73 @@ func GiftCatalogEntry(c *gin.Context, user models.User, profile *models.Profile,
 		return
 	}
 
-	if user.VBucks < offer.Prices[0].FinalPrice {
-		all.PrintRed([]any{"player does not have enough vbucks", user.VBucks, offer.Prices[0].FinalPrice})
+	body.ReceiverAccountIds = common.UniqueGiftReceivers(body.ReceiverAccountIds)
+	if balance := common.GetVBucksBalance(user.AccountId); balance < offer.Prices[0].FinalPrice * len(body.ReceiverAccountIds) {
+		all.PrintRed([]any{"player does not have enough vbucks", balance, offer.Prices[0].FinalPrice * len(body.ReceiverAccountIds)})
 		common.ErrorBadRequest(c)
 		c.Abort()
 		return
 	}
 
-	for _, friend := range body.ReceiverAccountIds {
-		friendAthenaProfile := common.GetFullAthenaProfile(friend)
-		if friendAthenaProfile.AccountId == "" {
-			continue
-		}
-
-		friendCommonCoreProfile, err := common.ReadProfileFromUser(friend, "common_core")
-		if err != nil {
-			common.ErrorBadRequest(c)
-			c.Abort()
-			continue
-		}
-
-		gift := models.CommonCoreItem{
-			TemplateId: body.GiftWrapTemplateId,
-			Attributes: gin.H{
-				"fromAccountId": user.AccountId,
-				"lootList": []gin.H{},
-				"params": gin.H{
-					"userMessage": body.PersonalMessage,
-				},
-				"level": 1,
-				"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-			},
-			Quantity: 1,
-		}
-
-		for _, item := range offer.ItemGrants {
-			_, hasItem := friendAthenaProfile.Items[item.TemplateID]
-			if hasItem {
-				all.PrintRed([]any{"friend already has item", friend})
-				common.ErrorUserAlreadyHasItem(c)
-				c.Abort()
-				continue
-			}
-
-			gift.Attributes["lootList"] = append(gift.Attributes["lootList"].([]gin.H), gin.H{
-				"itemType": item.TemplateID,
-				"itemGuid": item.TemplateID,
-				"itemProfile": "athena",
-				"quantity": 1,
-			})
-		}
-
-		if c.IsAborted() {
-			continue
-		}
+	oldHistory := common.GetGiftHistory(profile)
+	balance, err := common.SendGift(user.AccountId, profile, offer, body.ReceiverAccountIds, body.GiftWrapTemplateId, body.PersonalMessage)
+	if err != nil {
+		all.PrintRed([]any{"could not send gift", body.OfferId, err.Error()})
+		common.ErrorGift(c, err)
+		return
+	}
 
-		friendCommonCoreProfile.Rvn += 1
-		friendCommonCoreProfile.CommandRevision += 1
-		friendCommonCoreProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+	newHistory := common.GetGiftHistory(profile)
+	for _, gift := range newHistory.Gifts[len(oldHistory.Gifts):] {
+		socket.XMPPSendGiftReceived(gift.ToAccountId)
+	}
 
-		friendCommonCoreProfile.Items[body.GiftWrapTemplateId] = gift
-		common.SaveProfileToUser(friend, friendCommonCoreProfile)
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "itemQuantityChanged",
+		ItemID: "Currency:MtxPurchased",
+		Quantity: balance,
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "gift_history",
+		Value: profile.Stats.Attributes["gift_history"],
+	}, models.ProfileChange{
+		ChangeType: "statModified",
+		Name: "mtx_purchase_history",
+		Value: profile.Stats.Attributes["mtx_purchase_history"],
+	})
+}
 
-		for _, item := range offer.ItemGrants {
-			common.AddItemToProfile(&friendAthenaProfile, item.TemplateID, friend)
-			common.SaveProfileToUser(friend, friendAthenaProfile)
-		}
+func SetReceiveGiftsEnabled(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "common_core" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
 
-		socket.XMPPSendBodyToAccountId(gin.H{
-			"payload": gin.H{},
-			"type": "com.epicgames.gift.received",
-			"timestamp": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		}, friend)
+	var body struct {
+		ReceiveGifts bool `json:"bReceiveGifts"`
 	}
 
-	if c.IsAborted() {
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
 		return
 	}
 
-	common.TakeUserVBucks(user.AccountId, profile, offer.Prices[0].FinalPrice)
-	common.SaveProfileToUser(user.AccountId, *profile)
+	profile.Stats.Attributes["allowed_to_receive_gifts"] = body.ReceiveGifts
 
 	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
-		ChangeType: "itemQuantityChanged",
-		ItemID: "Currency:MtxPurchased",
-		Quantity: user.VBucks - offer.Prices[0].FinalPrice,
+		ChangeType: "statModified",
+		Name: "allowed_to_receive_gifts",
+		Value: body.ReceiveGifts,
 	})
 }
 
 func RemoveGiftBox(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
 	var body struct {
 		GiftBoxItemId string `json:"giftBoxItemId"`
+		GiftBoxItemIds []string `json:"giftBoxItemIds"`
 	}
 
 	if err := c.ShouldBind(&body); err != nil {
@@ -962,18 +1182,10 @@ func RemoveGiftBox(c *gin.Context, user models.User, profile *models.Profile, re
 		return
 	}
 
-	items := profile.Items
-	delete(items, body.GiftBoxItemId)
-	profile.Items = items
-	
-	common.SaveProfileToUser(user.AccountId, *profile)
-
-	
-	// all.MarshPrintJSON(profile)
-	// all.MarshPrintJSON(body)
+	giftBoxIds := body.GiftBoxItemIds
+	if body.GiftBoxItemId != "" {
+		giftBoxIds = append(giftBoxIds, body.GiftBoxItemId)
+	}
 
-	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
-		ChangeType: "fullProfileUpdate",
-		Profile: *profile,
-	})
+	response.ProfileChanges = append(response.ProfileChanges, common.RemoveGiftBoxes(profile, giftBoxIds)...)
 }
\ No newline at end of file
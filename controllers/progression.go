@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+	"github.com/zombman/server/socket"
+)
+
+type MatchXpReport struct {
+	AccountId string `json:"accountId" binding:"required"`
+	Xp int `json:"xp"`
+	WithFriends bool `json:"withFriends"`
+}
+
+func ReportMatchXp(c *gin.Context) {
+	var body struct {
+		Players []MatchXpReport `json:"players" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	results := []gin.H{}
+	for _, player := range body.Players {
+		if player.Xp <= 0 {
+			continue
+		}
+
+		athenaProfile, err := common.ReadProfileFromUser(player.AccountId, "athena")
+		if err != nil {
+			all.PrintRed([]any{"could not find athena profile for", player.AccountId})
+			continue
+		}
+
+		commonCore, err := common.ReadProfileFromUser(player.AccountId, "common_core")
+		if err != nil {
+			all.PrintRed([]any{"could not find common_core profile for", player.AccountId})
+			continue
+		}
+
+		granted := common.AddMatchXpToProfile(&athenaProfile, &commonCore, player.AccountId, player.Xp, player.WithFriends)
+
+		athenaProfile.Stats.Attributes["season_num"] = common.Season
+		athenaProfile.Rvn += 1
+		athenaProfile.CommandRevision = athenaProfile.Rvn
+		athenaProfile.AccountId = player.AccountId
+		athenaProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+		common.SaveProfileToUser(player.AccountId, athenaProfile)
+
+		if len(granted.CommonCoreChanges) > 0 || granted.VBucks > 0 {
+			commonCore.Rvn += 1
+			commonCore.CommandRevision = commonCore.Rvn
+			commonCore.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+			common.SaveProfileToUser(player.AccountId, commonCore)
+		}
+
+		socket.XMPPSendGiftReceived(player.AccountId)
+
+
+		results = append(results, gin.H{
+			"accountId": player.AccountId,
+			"profileRevision": athenaProfile.Rvn,
+			"profileChanges": granted.AthenaChanges,
+			"lootResult": models.LootResult{
+				Items: granted.LootItems,
+			},
+		})
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+	"github.com/zombman/server/socket"
+)
+
+func ClientQuestLogin(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	response.ProfileChanges = append(response.ProfileChanges, common.RefreshQuests(profile)...)
+}
+
+func FortRerollDailyQuest(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		QuestId string `json:"questId" binding:"required"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	newQuestId, changes, err := common.RerollDailyQuest(profile, body.QuestId)
+	if err != nil {
+		all.PrintRed([]any{"could not reroll daily quest", body.QuestId, err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	response.ProfileChanges = append(response.ProfileChanges, changes...)
+	response.Notifications = append(response.Notifications, models.Notification{
+		Type: "dailyQuestReroll",
+		Primary: true,
+		NewQuestId: newQuestId,
+	})
+}
+
+func MarkNewQuestNotificationSent(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "athena" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		ItemIds []string `json:"itemIds"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	response.ProfileChanges = append(response.ProfileChanges, common.MarkQuestNotificationsSent(profile, body.ItemIds)...)
+}
+
+type QuestProgressReport struct {
+	AccountId string `json:"accountId" binding:"required"`
+	Objectives map[string]int `json:"objectives"`
+}
+
+func ReportQuestProgress(c *gin.Context) {
+	var body struct {
+		Players []QuestProgressReport `json:"players" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	results := []gin.H{}
+	for _, player := range body.Players {
+		if len(player.Objectives) == 0 {
+			continue
+		}
+
+		athenaProfile, err := common.ReadProfileFromUser(player.AccountId, "athena")
+		if err != nil {
+			all.PrintRed([]any{"could not find athena profile for", player.AccountId})
+			continue
+		}
+
+		commonCore, err := common.ReadProfileFromUser(player.AccountId, "common_core")
+		if err != nil {
+			all.PrintRed([]any{"could not find common_core profile for", player.AccountId})
+			continue
+		}
+
+		granted := common.UpdateQuestProgress(&athenaProfile, &commonCore, player.AccountId, player.Objectives)
+		if len(granted.AthenaChanges) == 0 {
+			continue
+		}
+
+		athenaProfile.Rvn += 1
+		athenaProfile.CommandRevision = athenaProfile.Rvn
+		athenaProfile.AccountId = player.AccountId
+		athenaProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+		common.SaveProfileToUser(player.AccountId, athenaProfile)
+
+		if len(granted.CommonCoreChanges) > 0 || granted.VBucks > 0 {
+			commonCore.Rvn += 1
+			commonCore.CommandRevision = commonCore.Rvn
+			commonCore.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+			common.SaveProfileToUser(player.AccountId, commonCore)
+		}
+
+		socket.XMPPSendGiftReceived(player.AccountId)
+
+
+		results = append(results, gin.H{
+			"accountId": player.AccountId,
+			"profileRevision": athenaProfile.Rvn,
+			"profileChanges": granted.AthenaChanges,
+			"lootResult": models.LootResult{
+				Items: granted.LootItems,
+			},
+		})
+	}
+
+	c.JSON(http.StatusOK, results)
+}
@@ -0,0 +1,68 @@
+package controllers
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+)
+
+func RefundMtxPurchase(c *gin.Context, user models.User, profile *models.Profile, response *models.ProfileResponse) {
+	if profile.ProfileId != "common_core" {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	var body struct {
+		PurchaseId string `json:"purchaseId" binding:"required"`
+		QuickReturn bool `json:"quickReturn"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		all.PrintRed([]any{"could not bind body", err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	athenaProfile, err := common.ReadProfileFromUser(user.AccountId, "athena")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	refund, err := common.RefundMtxPurchase(user.AccountId, profile, &athenaProfile, body.PurchaseId, true)
+	if err != nil {
+		all.PrintRed([]any{"could not refund purchase", body.PurchaseId, err.Error()})
+		common.ErrorBadRequest(c)
+		c.Abort()
+		return
+	}
+
+	all.PrintGreen([]any{"refunded purchase", body.PurchaseId, "for", user.AccountId})
+
+	athenaProfile.Rvn += 1
+	athenaProfile.CommandRevision = athenaProfile.Rvn
+	athenaProfile.AccountId = user.AccountId
+	athenaProfile.Updated = time.Now().Format("2006-01-02T15:04:05.999Z")
+	common.SaveProfileToUser(user.AccountId, athenaProfile)
+
+	response.ProfileChanges = append(response.ProfileChanges, models.ProfileChange{
+		ChangeType: "itemQuantityChanged",
+		ItemID: "Currency:MtxPurchased",
+		Quantity: refund.VBucks,
+	})
+	response.ProfileChanges = append(response.ProfileChanges, refund.CommonCoreChanges...)
+
+	response.MultiUpdate = append(response.MultiUpdate, models.MultiUpdate{
+		ProfileRevision: athenaProfile.Rvn,
+		ProfileCommandRevision: athenaProfile.CommandRevision,
+		ProfileID: "athena",
+		ProfileChangesBaseRevision: athenaProfile.Rvn - 1,
+		ProfileChanges: refund.AthenaChanges,
+	})
+}
@@ -1,12 +1,10 @@
 package controllers
 
 import (
-	"bytes"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -15,169 +13,88 @@ import (
 	"github.com/zombman/server/models"
 )
 
-var ItemShop models.StorePage
-var ShouldRefresh int64 = 0
+var itemShopMutex sync.Mutex
 
 func StorefrontCatalog(c *gin.Context) {
-	timeNow := time.Now().Unix()
+	GenerateRandomItemShop()
 
-	if timeNow > ShouldRefresh {
-		RefreshItemShop()	
-	}
-	
-	if len(ItemShop.Storefronts) == 0 {
-		GenerateRandomItemShop()
+	itemShop, etag := common.GetCatalogForClient(c.GetHeader("User-Agent"))
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
 	}
 
-	if (common.LoadShopFromJson) {
-		pathToShop := "data/shop/shop.json"
+	c.JSON(http.StatusOK, itemShop)
+}
 
-		file, err := os.Open(pathToShop)
-		if err != nil {
-			all.PrintRed([]any{"error opening shop", err})
-			return
-		}
-		defer file.Close()
+func RefreshItemShop() {
+	loadItemShop(false)
+}
 
-		fileData, err := io.ReadAll(file)
-		if err != nil {
-			all.PrintRed([]any{"error reading shop", err})
-			return
+// swaps in the next shop at every rollover so planned shops go live on time
+// instead of waiting for the first catalog request, nothing else rolls the shop over
+func StartShopScheduler() {
+	go func() {
+		for {
+			time.Sleep(time.Until(common.NextShopRollover()) + time.Second)
+			RefreshItemShop()
 		}
-		str := string(bytes.ReplaceAll(bytes.ReplaceAll(fileData, []byte("\n"), []byte("")), []byte("\t"), []byte("")))
+	}()
+}
 
-		err = json.Unmarshal([]byte(str), &ItemShop)
-		if err != nil {
-			all.PrintRed([]any{"error unmarshalling shop", err})
-			return
-		}
+// requests only load a shop when none has been loaded since startup
+func GenerateRandomItemShop() {
+	if common.HasCatalog() {
+		return
+	}
+
+	itemShopMutex.Lock()
+	defer itemShopMutex.Unlock()
 
-		ItemShop.Expiration = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 23, 59, 59, 999999999, time.Now().Location()).Format("2006-01-02T15:04:05.999Z")
+	if !common.HasCatalog() {
+		buildItemShop(false)
 	}
+}
 
-	c.JSON(http.StatusOK, ItemShop)
+func RerollItemShop() {
+	loadItemShop(true)
 }
 
-func RefreshItemShop() {
-	ShouldRefresh = time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 23, 59, 59, 999999999, time.Now().Location()).Unix()
-	GenerateRandomItemShop()
+func loadItemShop(reroll bool) {
+	itemShopMutex.Lock()
+	defer itemShopMutex.Unlock()
+
+	buildItemShop(reroll)
 }
 
-func GenerateRandomItemShop() {
-	endOfDay := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 23, 59, 59, 999999999, time.Now().Location()).Format("2006-01-02T15:04:05.999Z")
-	allItems, err := common.GetItemsFromSeason(common.Season)
-	if err != nil {
-		return
-	}
+// a hand written shop.json replaces the rotation but still rolls over on time
+func buildItemShop(reroll bool) {
+	if common.LoadShopFromJson {
+		itemShop := common.GetItemShop()
+		itemShop.Expiration = common.NextShopRollover().Add(-time.Millisecond).Format("2006-01-02T15:04:05.999Z")
+		common.SetCatalog(itemShop)
 
-	common.ExcludeId(&allItems, "EID_AirHornRaisin")
-
-	legendaryItems := common.FilterRarity(allItems, "Legendary")
-	dailyItems := common.ExcludeRarity(allItems, "Legendary")
-
-	ItemShop = models.StorePage{
-		RefreshIntervalHrs: 24,
-		DailyPurchaseHrs:  24,
-		Expiration: endOfDay,
-		Storefronts: []models.Storefront{
-			{
-				Name: "BRDailyStorefront",
-				CatalogEntries: []models.CatalogEntry{},
-			},
-			{
-				Name: "BRWeeklyStorefront",
-				CatalogEntries: []models.CatalogEntry{},
-			},
-			{
-				Name: "BRSeasonStorefront",
-				CatalogEntries: []models.CatalogEntry{},
-			},
-			{
-				Name: "BRSeason8",
-				CatalogEntries: []models.CatalogEntry{
-					{
-						OfferID: "battlepassbundle" + fmt.Sprint(common.Season),
-						DevName: "BR.Season"+ fmt.Sprint(common.Season) +".BattleBundle.01",
-						OfferType: "StaticPrice",
-						Prices: []models.Price{{
-							CurrencyType: "MtxCurrency",
-							CurrencySubType: "",
-							RegularPrice: 4700,
-							FinalPrice: 2800,
-							SaleExpiration: "9999-12-31T23:59:59.999Z",
-							BasePrice: 2800,
-						}},
-						Categories: []string{},
-						DailyLimit: -1,
-						WeeklyLimit: -1,
-						MonthlyLimit: -1,
-						AppStoreID: []string{"", "", "", "", "", "", "", "", "", ""},
-						Requirements: []models.Requirement{{
-							RequirementType: "DenyOnFulfillment",
-							RequiredID: "battlepass"+ fmt.Sprint(common.Season),
-							MinQuantity: 1,
-						}},
-						MetaInfo: []gin.H{},
-						CatalogGroupPriority: 0,
-						SortPriority: 0,
-						DisplayAssetPath: "/Game/Catalog/DisplayAssets/DA_BR_Season"+ fmt.Sprint(common.Season) +"_BattlePassWithLevels.DA_BR_Season"+ fmt.Sprint(common.Season) +"_BattlePassWithLevels",
-						ItemGrants: []models.ItemGrant{},
-						Refundable: false,
-						Title: "Battle Bundle",
-						ShortDescription: "Battle Pass + 25 tiers!",
-						Description: "Season 8 \n\nInstantly get these items <Bold>valued at over 10,000 V-Bucks</>.\n  • <ItemName>Blackheart</> Progressive Outfit\n  • <ItemName>Hybrid</> Progressive Outfit\n  • <ItemName>Sidewinder</> Outfit\n  • <ItemName>Tropical Camo</> Wrap\n  • <ItemName>Woodsy</> Pet\n  • <ItemName>Sky Serpents</> Glider\n  • <ItemName>Cobra</> Back Bling\n  • <ItemName>Flying Standard</> Contrail\n  • 300 V-Bucks\n  • 1 Music Track\n  • <Bold>70% Bonus</> Season Match XP\n  • <Bold>20% Bonus</> Season Friend Match XP\n  • <Bold>Extra Weekly Challenges</>\n  • and more!\n\nPlay to level up your Battle Pass, unlocking <Bold>over 75 rewards</> (typically takes 75 to 150 hours of play).\n  • <Bold>4 more Outfits</>\n  • <Bold>1,000 V-Bucks</>\n  • 6 Emotes\n  • 5 Wraps\n  • 3 Gliders\n  • 3 Back Blings\n  • 4 Harvesting Tools\n  • 4 Contrails\n  • 1 Pet\n  • 12 Sprays\n  • 2 Music Tracks\n  • and so much more!\nWant it all faster? You can use V-Bucks to buy tiers any time!",
-					},
-					{
-						OfferID: "battlepass"+ fmt.Sprint(common.Season) +"",
-						DevName: "BR.Season"+ fmt.Sprint(common.Season) +".BattlePass.01",
-						OfferType: "StaticPrice",
-						Prices: []models.Price{{
-							CurrencyType: "MtxCurrency",
-							CurrencySubType: "",
-							RegularPrice: 950,
-							FinalPrice: 950,
-							SaleExpiration: "9999-12-31T23:59:59.999Z",
-							BasePrice: 950,
-						}},
-						Categories: []string{},
-						DailyLimit: -1,
-						WeeklyLimit: -1,
-						MonthlyLimit: -1,
-						AppStoreID: []string{"", "", "", "", "", "", "", "", "", ""},
-						Requirements: []models.Requirement{{
-							RequirementType: "DenyOnFulfillment",
-							RequiredID: "battlepass"+ fmt.Sprint(common.Season),
-							MinQuantity: 1,
-						}},
-						MetaInfo: []gin.H{},
-						CatalogGroupPriority: 0,
-						SortPriority: 0,
-						DisplayAssetPath: "/Game/Catalog/DisplayAssets/DA_BR_Season8_BattlePass.DA_BR_Season8_BattlePass",
-						ItemGrants: []models.ItemGrant{},
-						Refundable: false,
-						Title: "Battle Pass",
-						ShortDescription: "Season"+ fmt.Sprint(common.Season),
-						Description: "Season 8 \n\nInstantly get these items <Bold>valued at over 3,500 V-Bucks</>.\n  • <ItemName>Blackheart</> Progressive Outfit\n  • <ItemName>Hybrid</> Progressive Outfit\n  • <Bold>50% Bonus</> Season Match XP\n  • <Bold>10% Bonus</> Season Friend Match XP\n  • <Bold>Extra Weekly Challenges</>\n\nPlay to level up your Battle Pass, unlocking <Bold>over 100 rewards</> (typically takes 75 to 150 hours of play).\n  • <ItemName>Sidewinder</> and <Bold>4 more Outfits</>\n  • <Bold>1,300 V-Bucks</>\n  • 7 Emotes\n  • 6 Wraps\n  • 2 Pets\n  • 5 Harvesting Tools\n  • 4 Gliders\n  • 4 Back Blings\n  • 5 Contrails\n  • 14 Sprays\n  • 3 Music Tracks\n  • 1 Toy\n  • 20 Loading Screens\n  • and so much more!\nWant it all faster? You can use V-Bucks to buy tiers any time!",
-					},
-				},
-			},
-		},
-	}
+		date := common.ShopDate(time.Now())
+		common.RecordShopAppearances(date, itemShop)
+		common.SaveShopHistory(date, 0, 0, itemShop)
+		go announceItemShop(itemShop)
 
-	for i := 0; i < 6; i++ {
-		entry := common.GenerateRandomCatalogEntry(-1, &dailyItems, "Small")
-		ItemShop.Storefronts[0].CatalogEntries = append(ItemShop.Storefronts[0].CatalogEntries, entry)
+		all.PrintGreen([]any{"loaded item shop from json"})
+		return
 	}
 
-	for i := 0; i < 2; i++ {
-		entry := common.GenerateRandomCatalogEntry(1, &legendaryItems, "Normal")
-		ItemShop.Storefronts[1].CatalogEntries = append(ItemShop.Storefronts[1].CatalogEntries, entry)
+	itemShop, err := common.GenerateItemShop(reroll)
+	if err != nil {
+		all.PrintRed([]any{"could not generate item shop", err.Error()})
+		return
 	}
-	
+	common.SetCatalog(itemShop)
+	go announceItemShop(itemShop)
+
 	all.PrintGreen([]any{"generated new random item shop"})
-	if !common.LoadShopFromJson {
-		SaveItemShop()
-	}
+	SaveItemShop()
 }
 
 func SaveItemShop() {
@@ -187,7 +104,7 @@ func SaveItemShop() {
 	}
 	defer file.Close()
 
-	data, err := json.MarshalIndent(ItemShop, "", "\t")
+	data, err := json.MarshalIndent(common.GetCatalog(), "", "\t")
 	if err != nil {
 		return
 	}
@@ -210,6 +127,13 @@ func CheckGiftStatus(c *gin.Context) {
 		return
 	}
 
+	user := c.MustGet("user").(models.User)
+	if err := common.CheckGiftRecipient(user.AccountId, itemShopEntry, recipient); err != nil {
+		all.PrintRed([]any{"recipient can not receive offer", recipient, err.Error()})
+		common.ErrorGift(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"price": itemShopEntry.Prices[0],
 		"items": itemShopEntry.ItemGrants,
@@ -2,10 +2,10 @@ package controllers
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -177,21 +177,13 @@ func UserGetLocker(c *gin.Context) {
 		return
 	}
 
-	if common.AllItems == nil {
-		common.GetAllFortniteItems()
-	}
-
 	locker := []LockerItem{}
 	for _, item := range items {
 		if item.TemplateId == "Currency:MtxPurchased" || item.TemplateId == "CosmeticLocker:cosmeticlocker_athena" {
 			continue
 		}
 
-		if len(common.AllItemsKeys) == 0 {
-			common.GetAllFortniteItems()
-		}
-
-		itemInfo, ok := common.AllItemsKeys[item.TemplateId]
+		itemInfo, ok := common.GetItem(item.TemplateId)
 		if !ok {
 			continue
 		}
@@ -270,7 +262,7 @@ func UserUpdate(c *gin.Context) {
 		user.Password = all.HashString(body.Password)
 	}
 
-	result := all.Postgres.Save(&user)
+	result := all.Postgres.Omit("v_bucks").Save(&user)
 	if result.Error != nil {
 		common.ErrorInternalServer(c)
 		return
@@ -410,43 +402,19 @@ func AdminSaveProfile(c *gin.Context) {
 	common.AppendLoadoutsToProfile(&defaultAthenaProfile, user.AccountId)
 	common.AppendLoadoutsToProfile(&defaultCommonCoreProfile, user.AccountId)
 
-	commonCore, err := common.ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				
-			},
-			"params": gin.H{
-				"userMessage": "Server has updated your account. Enjoy!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
-		Quantity: 1,
-	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	common.SaveProfileToUser(accountId, commonCore)
+	common.SendServerGiftBox(accountId, []models.LootResultItem{}, "Server has updated your account. Enjoy!")
 
-	if body.User.VBucks != 0 {
-		user.VBucks = body.User.VBucks
-		gift.Attributes["lootList"] = append(gift.Attributes["lootList"].([]gin.H), gin.H{
-			"itemType": "MtxCurrency:MTXCurrency",
-			"itemGuid": "MtxCurrency:MTXCurrency",
-			"itemProfile": "athena",
-			"quantity": user.VBucks - body.User.VBucks,
-		})
+	if body.User.VBucks != user.VBucks {
+		_, err := common.SetUserVBucks(accountId, &defaultCommonCoreProfile, body.User.VBucks, common.VBucksReasonAdmin, me.AccountId)
+		if err != nil {
+			all.PrintRed([]any{"could not set vbucks", accountId, err.Error()})
+		}
 	}
 	if user.Username != "admin" && body.User.AccessLevel != 0 {
 		user.AccessLevel = body.User.AccessLevel
 	}
 	user.Banned = body.User.Banned
-	all.Postgres.Save(&user)
+	all.Postgres.Omit("v_bucks").Save(&user)
 
 	socket.XMPPSendBodyToAccountId(gin.H{
 		"payload": gin.H{},
@@ -521,33 +489,12 @@ func AdminGiveItem(c *gin.Context) {
 		return
 	}
 
-	commonCore, err := common.ReadProfileFromUser(accountId, "common_core")
-	if err != nil {
-		return
-	}
-
-	gift := models.CommonCoreItem{
-		TemplateId: "GiftBox:gb_default",
-		Attributes: gin.H{
-			"fromAccountId": "Server",
-			"lootList": []gin.H{
-				{
-					"itemType": itemId,
-					"itemGuid": itemId,
-					"itemProfile": "athena",
-					"quantity": 1,
-				},
-			},
-			"params": gin.H{
-				"userMessage": "Enjoy this gift from the server!",
-			},
-			"level": 1,
-			"giftedOn": time.Now().Format("2006-01-02T15:04:05.999Z"),
-		},
+	common.SendServerGiftBox(accountId, []models.LootResultItem{{
+		ItemType: itemId,
+		ItemGuid: itemId,
+		ItemProfile: "athena",
 		Quantity: 1,
-	}
-	commonCore.Items["GiftBox:gb_default"] = gift
-	common.SaveProfileToUser(accountId, commonCore)
+	}}, "Enjoy this gift from the server!")
 
 	common.AddItemToProfile(&profile, itemId, accountId)
 	common.AppendLoadoutsToProfile(&profile, accountId)
@@ -561,6 +508,52 @@ func AdminGiveItem(c *gin.Context) {
 	c.JSON(http.StatusOK, profile)
 }
 
+func AdminGiveVariants(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	accountId := c.Param("accountId")
+	itemId := c.Param("itemId")
+
+	var body struct {
+		Channel string `json:"channel" binding:"required"`
+		Tags []string `json:"tags" binding:"required"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	profile, err := common.ReadProfileFromUser(accountId, "athena")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	item, err := common.GetItemFromProfile(&profile, itemId)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	if err := common.GrantVariantTags(&item, body.Channel, body.Tags); err != nil {
+		all.PrintRed([]any{"could not grant variants", err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	profile.Items[itemId] = item
+	common.AppendLoadoutsToProfile(&profile, accountId)
+
+	socket.XMPPSendGiftReceived(accountId)
+
+	c.JSON(http.StatusOK, item)
+}
+
 func AdminTakeAllSkins(c * gin.Context) {
 	me := c.MustGet("user").(models.User)
 	if me.AccessLevel < 1 {
@@ -652,24 +645,21 @@ func AdminGetLocker(c *gin.Context) {
 		return
 	}
 
-	if common.AllItems == nil {
-		common.GetAllFortniteItems()
-	}
-
 	locker := []LockerItem{}
 	for _, item := range items {
 		if item.TemplateId == "Currency:MtxPurchased" || item.TemplateId == "CosmeticLocker:cosmeticlocker_athena" {
 			continue
 		}
 
-		if common.AllItemsKeys[item.TemplateId].IntroductionSeason > 20 {
+		itemInfo, _ := common.GetItem(item.TemplateId)
+		if itemInfo.IntroductionSeason > 20 {
 			continue
 		}
 
 		locker = append(locker, LockerItem{
 			ItemId: item.TemplateId,
-			Rarity: common.AllItemsKeys[item.TemplateId].Rarity,
-			Season: common.AllItemsKeys[item.TemplateId].IntroductionSeason,
+			Rarity: itemInfo.Rarity,
+			Season: itemInfo.IntroductionSeason,
 		})
 	}
 
@@ -720,53 +710,130 @@ func AdminTakeUserAdmin(c *gin.Context) {
 	c.JSON(http.StatusOK, user)
 }
 
+func siteShopItems(page models.StorePage, storefrontName string) []models.SiteShopItem {
+	items := []models.SiteShopItem{}
+	for _, storefront := range page.Storefronts {
+		if storefront.Name != storefrontName {
+			continue
+		}
+
+		for _, item := range storefront.CatalogEntries {
+			if len(item.ItemGrants) == 0 {
+				continue
+			}
+
+			simpleItem, ok := common.GetItem(item.ItemGrants[0].TemplateID)
+			if !ok {
+				continue
+			}
+
+			items = append(items, models.SiteShopItem{
+				ItemId: item.ItemGrants[0].TemplateID,
+				Price: item.Prices[0].FinalPrice,
+				Rarity: simpleItem.Rarity,
+				Season: simpleItem.IntroductionSeason,
+				Name: simpleItem.Name,
+			})
+		}
+	}
+
+	return items
+}
+
 func GetFriendlyShop(c *gin.Context) {
-	realShop := ItemShop
+	GenerateRandomItemShop()
 
-	fmt.Println(len(realShop.Storefronts))
+	c.JSON(http.StatusOK, friendlyShop(common.ApplyShopSales(common.GetCatalog())))
+}
 
-	if len(realShop.Storefronts) == 0 {
-		GenerateRandomItemShop()
+func friendlyShop(page models.StorePage) gin.H {
+	return gin.H{
+		"daily": siteShopItems(page, "BRDailyStorefront"),
+		"featured": siteShopItems(page, "BRWeeklyStorefront"),
 	}
+}
 
-	daily := []models.SiteShopItem{}
-	for _, item := range realShop.Storefronts[0].CatalogEntries {
-		simpleItem, ok := common.AllItemsKeys[item.ItemGrants[0].TemplateID]
-		if !ok {
-			continue
-		}
+func GetShopImage(c *gin.Context) {
+	GenerateRandomItemShop()
 
-		daily = append(daily, models.SiteShopItem{
-			ItemId: item.ItemGrants[0].TemplateID,
-			Price: item.Prices[0].FinalPrice,
-			Rarity: simpleItem.Rarity,
-			Season: simpleItem.IntroductionSeason,
-			Name: simpleItem.Name,
-		})
+	data, etag, err := common.GetShopImage()
+	if err != nil {
+		all.PrintRed([]any{"could not render shop image", err.Error()})
+		common.ErrorInternalServer(c)
+		return
 	}
 
-	featured := []models.SiteShopItem{}
-	for _, item := range realShop.Storefronts[1].CatalogEntries {
-		simpleItem, ok := common.AllItemsKeys[item.ItemGrants[0].TemplateID]
-		if !ok {
-			continue
-		}
+	c.Header("ETag", etag)
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
 
-		featured = append(featured, models.SiteShopItem{
-			ItemId: item.ItemGrants[0].TemplateID,
-			Price: item.Prices[0].FinalPrice,
-			Rarity: simpleItem.Rarity,
-			Season: simpleItem.IntroductionSeason,
-			Name: simpleItem.Name,
+	c.Data(http.StatusOK, "image/png", data)
+}
+
+func GetShopHistory(c *gin.Context) {
+	date := c.Query("date")
+	if date == "" {
+		c.JSON(http.StatusOK, gin.H{
+			"dates": common.GetShopHistoryDates(),
 		})
+		return
+	}
+
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	history, err := common.GetShopHistory(date)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	page, err := common.GetShopHistoryPage(history)
+	if err != nil {
+		common.ErrorInternalServer(c)
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"daily": daily,
-		"featured": featured,
+		"date": history.Date,
+		"daily": siteShopItems(page, "BRDailyStorefront"),
+		"featured": siteShopItems(page, "BRWeeklyStorefront"),
 	})
 }
 
+func SearchItems(c *gin.Context) {
+	query := models.ItemQuery{
+		Type: c.Query("type"),
+		Rarity: c.Query("rarity"),
+		Set: c.Query("set"),
+		Search: c.Query("q"),
+		Limit: 100,
+	}
+
+	var err error
+	for key, value := range map[string]*int{"season": &query.Season, "offset": &query.Offset, "limit": &query.Limit} {
+		if c.Query(key) == "" {
+			continue
+		}
+
+		*value, err = strconv.Atoi(c.Query(key))
+		if err != nil || *value < 0 {
+			common.ErrorBadRequest(c)
+			return
+		}
+	}
+
+	if query.Limit > 500 {
+		query.Limit = 500
+	}
+
+	c.JSON(http.StatusOK, common.SearchItems(query))
+}
+
 func AdminChangeShop(c *gin.Context) {
 	me := c.MustGet("user").(models.User)
 	if me.AccessLevel < 2 {
@@ -774,6 +841,468 @@ func AdminChangeShop(c *gin.Context) {
 		return
 	}
 	
-	GenerateRandomItemShop()
+	RerollItemShop()
 	GetFriendlyShop(c)
+}
+
+func AdminGetShopPlans(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.GetUpcomingShopPlans())
+}
+
+func AdminGetShopPlan(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	plan, err := common.GetShopPlan(c.Param("date"))
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	preview, err := common.PreviewShopPlan(plan)
+	if err != nil {
+		all.PrintRed([]any{"could not preview shop plan", plan.Date, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"plan": plan,
+		"preview": preview,
+	})
+}
+
+func AdminSaveShopPlan(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body models.ShopPlanRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	preview, err := common.PreviewShopPlan(body)
+	if err != nil {
+		all.PrintRed([]any{"could not build shop plan", body.Date, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	_, err = common.SaveShopPlan(body, me.AccountId)
+	if err != nil {
+		all.PrintRed([]any{"could not save shop plan", body.Date, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	// a plan for the running shop day goes live straight away
+	config, _ := common.GetShopRotationConfig()
+	day, _ := common.ShopRollover(config, time.Now())
+	if body.Date == day.Format("2006-01-02") {
+		RefreshItemShop()
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"plan": body,
+		"preview": preview,
+	})
+}
+
+func AdminDeleteShopPlan(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	err := common.DeleteShopPlan(c.Param("date"))
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func AdminGetShopSales(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.GetShopSales())
+}
+
+func AdminCreateShopSale(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body struct {
+		TemplateId string `json:"templateId"`
+		Storefront string `json:"storefront"`
+		DiscountPercent int `json:"discountPercent" binding:"required"`
+		StartsAt time.Time `json:"startsAt"`
+		EndsAt time.Time `json:"endsAt" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	sale, err := common.CreateShopSale(models.ShopSale{
+		TemplateId: body.TemplateId,
+		Storefront: body.Storefront,
+		DiscountPercent: body.DiscountPercent,
+		StartsAt: body.StartsAt,
+		EndsAt: body.EndsAt,
+		CreatedBy: me.AccountId,
+	})
+	if err != nil {
+		all.PrintRed([]any{"could not create shop sale", err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, sale)
+}
+
+func AdminDeleteShopSale(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	err := common.DeleteShopSale(c.Param("id"))
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func AdminGetKeychain(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	keys, err := common.GetKeychainKeys()
+	if err != nil {
+		all.PrintRed([]any{"could not load keychain", err.Error()})
+		common.ErrorInternalServer(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, keys)
+}
+
+func AdminAddKeychainKey(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body models.KeychainKey
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	key, err := common.AddKeychainKey(body)
+	if err != nil {
+		all.PrintRed([]any{"could not add keychain key", body.Guid, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, key)
+}
+
+func AdminRemoveKeychainKey(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	err := common.RemoveKeychainKey(c.Param("guid"), c.Query("cosmetic"))
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func AdminRolloverSeason(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body struct {
+		Season int `json:"season"`
+	}
+	c.ShouldBind(&body)
+
+	if body.Season == 0 {
+		body.Season = common.Season
+	}
+
+	rolledAccountIds, err := common.RolloverSeason(body.Season)
+	if err != nil {
+		all.PrintRed([]any{"could not roll over to season", body.Season, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	for _, accountId := range rolledAccountIds {
+		socket.XMPPSendGiftReceived(accountId)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"season": body.Season,
+		"accounts": len(rolledAccountIds),
+	})
+}
+
+func AdminGetSeasonHistory(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var history []models.SeasonHistory
+	all.Postgres.Where("account_id = ?", c.Param("accountId")).Order("season").Find(&history)
+
+	c.JSON(http.StatusOK, history)
+}
+
+func AdminGetVBucksHistory(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	accountId := c.Param("accountId")
+
+	c.JSON(http.StatusOK, gin.H{
+		"balance": common.GetVBucksBalance(accountId),
+		"transactions": common.GetVBucksHistory(accountId),
+	})
+}
+
+func AdminRefundMtxPurchase(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	accountId := c.Param("accountId")
+	purchaseId := c.Param("purchaseId")
+
+	commonCore, err := common.ReadProfileFromUser(accountId, "common_core")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	athenaProfile, err := common.ReadProfileFromUser(accountId, "athena")
+	if err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	refund, err := common.RefundMtxPurchase(accountId, &commonCore, &athenaProfile, purchaseId, false)
+	if err != nil {
+		all.PrintRed([]any{"could not refund purchase", purchaseId, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	common.SaveProfileToUser(accountId, commonCore)
+	common.SaveProfileToUser(accountId, athenaProfile)
+
+	socket.XMPPSendGiftReceived(accountId)
+
+	c.JSON(http.StatusOK, gin.H{
+		"purchase": refund.Purchase,
+		"balance": refund.VBucks,
+	})
+}
+
+func AdminGetCreatorCodes(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.GetAllCreatorCodes())
+}
+
+func AdminCreateCreatorCode(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body struct {
+		Code string `json:"code" binding:"required"`
+		AccountId string `json:"accountId"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	if body.AccountId != "" {
+		var owner models.User
+		result := all.Postgres.Where("account_id = ?", body.AccountId).First(&owner)
+		if result.Error != nil {
+			common.ErrorBadRequest(c)
+			return
+		}
+	}
+
+	creatorCode, err := common.CreateCreatorCode(body.Code, body.AccountId, me.AccountId)
+	if err != nil {
+		all.PrintRed([]any{"could not create creator code", body.Code, err.Error()})
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, creatorCode)
+}
+
+func AdminDisableCreatorCode(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	creatorCode, err := common.SetCreatorCodeEnabled(c.Param("code"), false)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, creatorCode)
+}
+
+func AdminGetCreatorReport(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+
+	if c.Query("from") != "" {
+		parsed, err := time.Parse("2006-01-02", c.Query("from"))
+		if err != nil {
+			common.ErrorBadRequest(c)
+			return
+		}
+		from = parsed
+	}
+
+	// the end date is inclusive so a single day report is from=to
+	if c.Query("to") != "" {
+		parsed, err := time.Parse("2006-01-02", c.Query("to"))
+		if err != nil {
+			common.ErrorBadRequest(c)
+			return
+		}
+		to = parsed.AddDate(0, 0, 1)
+	}
+
+	report, err := common.GetCreatorReport(c.Param("code"), from, to)
+	if err != nil {
+		common.ErrorItemNotFound(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+func AdminSendGift(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	var body struct {
+		AccountIds []string `json:"accountIds"`
+		AllPlayers bool `json:"allPlayers"`
+		Message string `json:"message"`
+		GiftWrapTemplateId string `json:"giftWrapTemplateId"`
+		LootList []models.LootResultItem `json:"lootList"`
+	}
+
+	if err := c.ShouldBind(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	if body.AllPlayers {
+		body.AccountIds = []string{}
+		all.Postgres.Model(&models.User{}).Where("account_id IS NOT NULL AND account_id != ''").Pluck("account_id", &body.AccountIds)
+	}
+
+	rewards := []models.BattlePassReward{}
+	for _, loot := range body.LootList {
+		rewards = append(rewards, models.BattlePassReward{
+			TemplateId: loot.ItemType,
+			Quantity: loot.Quantity,
+		})
+	}
+
+	sent := []string{}
+	for _, accountId := range body.AccountIds {
+		_, err := common.SendAdminGift(accountId, rewards, body.Message, body.GiftWrapTemplateId)
+		if err != nil {
+			all.PrintRed([]any{"could not send gift to", accountId, err.Error()})
+			continue
+		}
+
+		socket.XMPPSendGiftReceived(accountId)
+		sent = append(sent, accountId)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sent": sent,
+	})
 }
\ No newline at end of file
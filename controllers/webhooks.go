@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+)
+
+// runs after every rotation, reroll and startup load of the shop
+func announceItemShop(page models.StorePage) {
+	NotifyShopWebhook(page)
+	NotifyWishlists(page)
+}
+
+// the same shop loaded twice, like after a restart, is only announced once
+func NotifyShopWebhook(page models.StorePage) {
+	page = common.ApplyShopSales(page)
+	_, etag := common.GetCatalogForClient("")
+
+	if !common.ClaimShopAnnouncement(common.ShopDate(time.Now()), etag) {
+		return
+	}
+
+	payload := friendlyShop(page)
+	payload["date"] = common.ShopDate(time.Now())
+	payload["expiration"] = page.Expiration
+	payload["image"] = "http://" + common.IP + "/api/shop/image"
+
+	common.DispatchWebhook("shop.rotated", payload)
+}
+
+// lets WEBHOOK_URL point back at this server to check deliveries and signatures locally
+func WebhookReceiver(c *gin.Context) {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	if !common.VerifyWebhookSignature(body, c.GetHeader("X-Webhook-Signature")) {
+		all.PrintRed([]any{"webhook receiver got a bad signature", c.GetHeader("X-Webhook-Event")})
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	all.PrintGreen([]any{"webhook receiver got", c.GetHeader("X-Webhook-Event"), len(body), "bytes"})
+	c.Status(http.StatusNoContent)
+}
+
+func AdminTestWebhook(c *gin.Context) {
+	me := c.MustGet("user").(models.User)
+	if me.AccessLevel < 1 {
+		common.ErrorUnauthorized(c)
+		return
+	}
+
+	if common.WebhookUrl == "" {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	err := common.SendWebhook("ping", gin.H{
+		"sentBy": me.AccountId,
+	})
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"delivered": false,
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"delivered": true,
+	})
+}
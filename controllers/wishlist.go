@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zombman/server/all"
+	"github.com/zombman/server/common"
+	"github.com/zombman/server/models"
+	"github.com/zombman/server/socket"
+)
+
+func UserGetWishlist(c *gin.Context) {
+	user := c.MustGet("user").(models.User)
+
+	c.JSON(http.StatusOK, common.GetWishlist(user.AccountId))
+}
+
+func UserAddWishlistItem(c *gin.Context) {
+	user := c.MustGet("user").(models.User)
+
+	var body struct {
+		TemplateId string `json:"templateId" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&body); err != nil {
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	item, err := common.AddWishlistItem(user.AccountId, body.TemplateId)
+	if err != nil {
+		if errors.Is(err, common.ErrWishlistUnknownItem) {
+			common.ErrorItemNotFound(c)
+			return
+		}
+
+		common.ErrorBadRequest(c)
+		return
+	}
+
+	c.JSON(http.StatusOK, item)
+}
+
+func UserRemoveWishlistItem(c *gin.Context) {
+	user := c.MustGet("user").(models.User)
+
+	err := common.RemoveWishlistItem(user.AccountId, c.Param("templateId"))
+	if err != nil {
+		if errors.Is(err, common.ErrWishlistItemNotFound) {
+			common.ErrorItemNotFound(c)
+			return
+		}
+
+		common.ErrorInternalServer(c)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func wishlistOffers(page models.StorePage, items []models.WishlistItem) []gin.H {
+	wishlisted := map[string]bool{}
+	for _, item := range items {
+		wishlisted[item.TemplateId] = true
+	}
+
+	offers := []gin.H{}
+	for _, storefront := range page.Storefronts {
+		for _, entry := range storefront.CatalogEntries {
+			for _, grant := range entry.ItemGrants {
+				if !wishlisted[grant.TemplateID] {
+					continue
+				}
+
+				offer := gin.H{
+					"templateId": grant.TemplateID,
+					"offerId": entry.OfferID,
+					"storefront": storefront.Name,
+				}
+
+				if len(entry.Prices) > 0 {
+					offer["price"] = entry.Prices[0].FinalPrice
+				}
+
+				if item, ok := common.GetItem(grant.TemplateID); ok {
+					offer["name"] = item.Name
+					offer["rarity"] = item.Rarity
+				}
+
+				offers = append(offers, offer)
+			}
+		}
+	}
+
+	return offers
+}
+
+// tells players in game and through the webhook when something they wishlisted is in the shop
+func NotifyWishlists(page models.StorePage) {
+	date := common.ShopDate(time.Now())
+	page = common.ApplyShopSales(page)
+
+	for accountId, items := range common.GetWishlistMatches(page, date) {
+		offers := wishlistOffers(page, items)
+		if len(offers) == 0 {
+			continue
+		}
+
+		socket.XMPPSendBodyToAccountId(gin.H{
+			"payload": gin.H{
+				"date": date,
+				"offers": offers,
+			},
+			"type": "com.zombman.wishlist.available",
+			"timestamp": time.Now().Format("2006-01-02T15:04:05.999Z"),
+		}, accountId)
+
+		user, err := common.GetUserByAccountId(accountId)
+		if err != nil {
+			all.PrintRed([]any{"could not find wishlist owner", accountId, err.Error()})
+		} else {
+			common.DispatchWebhook("wishlist.available", gin.H{
+				"accountId": accountId,
+				"username": user.Username,
+				"discordId": user.DiscordId,
+				"date": date,
+				"offers": offers,
+			})
+		}
+
+		common.MarkWishlistNotified(items, date)
+	}
+}
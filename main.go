@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/zombman/server/all"
@@ -19,6 +20,11 @@ func init() {
   all.ConnectToDatabase()
   all.AutoMigrate()
   common.InitGameServers()
+
+  if err := common.ValidatePriceTable(); err != nil {
+    panic(err)
+  }
+
   socket.InitMatchmaker()
 
   var adminUser models.User
@@ -85,6 +91,23 @@ func main() {
     }
   }
 
+  if strings.Contains(args, "-migrate_profiles") {
+    fmt.Println("Migrating profiles to version", common.CurrentProfileSchemaVersion())
+    migrated, failed := common.MigrateAllProfiles()
+    fmt.Println("Migrated", migrated, "profiles,", failed, "failed")
+  }
+
+  if strings.Contains(args, "-rollover_season") {
+    fmt.Println("Rolling over to season", common.Season)
+    rolledAccountIds, _ := common.RolloverSeason(common.Season)
+    fmt.Println("Rolled over", len(rolledAccountIds), "accounts")
+  }
+
+  if strings.Contains(args, "-reconcile_vbucks") {
+    reconciled, failed := common.ReconcileVBucks()
+    fmt.Println("Reconciled vbucks for", reconciled, "users,", failed, "failed")
+  }
+
   if strings.Contains(args, "-return") {
     return
   }
@@ -101,14 +124,32 @@ func main() {
   {
     site.GET("/google", controllers.GetGoogleRecaptcha)
     site.GET("/shop", controllers.GetFriendlyShop)
+    site.GET("/shop/history", controllers.GetShopHistory)
+    site.GET("/shop/image", controllers.GetShopImage)
+    site.POST("/webhooks/receiver", controllers.WebhookReceiver)
+    site.GET("/items", controllers.SearchItems)
 
     site.POST("/user/login", controllers.UserLogin)
     site.POST("/user/create", middleware.RateLimitMiddleware(1, 1), controllers.UserCreate)
     site.POST("/user/refresh", controllers.SiteRefresh)
     site.POST("/user/update", middleware.VerifySiteToken, controllers.UserUpdate)
     site.GET("/user/locker", middleware.VerifySiteToken, controllers.UserGetLocker)
+    site.GET("/user/wishlist", middleware.VerifySiteToken, controllers.UserGetWishlist)
+    site.POST("/user/wishlist", middleware.VerifySiteToken, controllers.UserAddWishlistItem)
+    site.DELETE("/user/wishlist/:templateId", middleware.VerifySiteToken, controllers.UserRemoveWishlistItem)
 
     site.POST("/admin/shop", middleware.VerifySiteToken, controllers.AdminChangeShop)
+    site.GET("/admin/shop/plans", middleware.VerifySiteToken, controllers.AdminGetShopPlans)
+    site.GET("/admin/shop/plans/:date", middleware.VerifySiteToken, controllers.AdminGetShopPlan)
+    site.POST("/admin/shop/plans", middleware.VerifySiteToken, controllers.AdminSaveShopPlan)
+    site.DELETE("/admin/shop/plans/:date", middleware.VerifySiteToken, controllers.AdminDeleteShopPlan)
+    site.GET("/admin/shop/sales", middleware.VerifySiteToken, controllers.AdminGetShopSales)
+    site.POST("/admin/shop/sales", middleware.VerifySiteToken, controllers.AdminCreateShopSale)
+    site.DELETE("/admin/shop/sales/:id", middleware.VerifySiteToken, controllers.AdminDeleteShopSale)
+    site.GET("/admin/keychain", middleware.VerifySiteToken, controllers.AdminGetKeychain)
+    site.POST("/admin/keychain", middleware.VerifySiteToken, controllers.AdminAddKeychainKey)
+    site.DELETE("/admin/keychain/:guid", middleware.VerifySiteToken, controllers.AdminRemoveKeychainKey)
+    site.POST("/admin/webhooks/test", middleware.VerifySiteToken, controllers.AdminTestWebhook)
     site.GET("/admin/users", middleware.VerifySiteToken, controllers.AdminGetAllUsers)
     site.GET("/admin/locker/:accountId", middleware.VerifySiteToken, controllers.AdminGetLocker)
     site.POST("/admin/user/:accountId/give/admin", middleware.VerifySiteToken, controllers.AdminGiveUserAdmin)
@@ -117,8 +158,18 @@ func main() {
     site.POST("/admin/profile/accountId/:accountId", middleware.VerifySiteToken, controllers.AdminSaveProfile)
     site.POST("/admin/profile/accountId/:accountId/give/all", middleware.VerifySiteToken, controllers.AdminGiveAllSkins)
     site.POST("/admin/profile/accountId/:accountId/give/:itemId", middleware.VerifySiteToken, controllers.AdminGiveItem)
+    site.POST("/admin/profile/accountId/:accountId/variants/:itemId", middleware.VerifySiteToken, controllers.AdminGiveVariants)
     site.POST("/admin/profile/accountId/:accountId/take/all", middleware.VerifySiteToken, controllers.AdminTakeAllSkins)
     site.POST("/admin/profile/accountId/:accountId/take/:itemId", middleware.VerifySiteToken, controllers.AdminTakeItem)
+    site.POST("/admin/gifts", middleware.VerifySiteToken, controllers.AdminSendGift)
+    site.POST("/admin/season/rollover", middleware.VerifySiteToken, controllers.AdminRolloverSeason)
+    site.GET("/admin/season/history/:accountId", middleware.VerifySiteToken, controllers.AdminGetSeasonHistory)
+    site.GET("/admin/vbucks/:accountId", middleware.VerifySiteToken, controllers.AdminGetVBucksHistory)
+    site.POST("/admin/vbucks/:accountId/refund/:purchaseId", middleware.VerifySiteToken, controllers.AdminRefundMtxPurchase)
+    site.GET("/admin/creators", middleware.VerifySiteToken, controllers.AdminGetCreatorCodes)
+    site.POST("/admin/creators", middleware.VerifySiteToken, controllers.AdminCreateCreatorCode)
+    site.POST("/admin/creators/:code/disable", middleware.VerifySiteToken, controllers.AdminDisableCreatorCode)
+    site.GET("/admin/creators/:code/report", middleware.VerifySiteToken, controllers.AdminGetCreatorReport)
   }
 
   r.GET("/account/api/oauth/verify",  middleware.VerifyAccessToken, controllers.OAuthVerify)
@@ -174,6 +225,8 @@ func main() {
     
     fortnite.POST("/matchmaking/zomb/server", middleware.ServerSecret, controllers.AddNewGameServer)
     fortnite.DELETE("/matchmaking/zomb/server", middleware.ServerSecret, controllers.RemoveGameServer)
+    fortnite.POST("/matchmaking/zomb/xp", middleware.ServerSecret, controllers.ReportMatchXp)
+    fortnite.POST("/matchmaking/zomb/quests", middleware.ServerSecret, controllers.ReportQuestProgress)
     
     fortnite.GET("/v2/versioncheck/Windows", controllers.UpdateCheck)
     
@@ -258,5 +311,7 @@ func main() {
     c.File("./public/index.html")
   })
 
+  controllers.StartShopScheduler()
+  common.WatchItemDatabase(time.Second * 5)
   r.Run()
 }
\ No newline at end of file
@@ -0,0 +1,23 @@
+package models
+
+type BattlePass struct {
+	BattleBundleOfferId string           `json:"battleBundleOfferId"`
+	BattlePassOfferId   string           `json:"battlePassOfferId"`
+	TierOfferId         string           `json:"tierOfferId"`
+	PaidRewards         []map[string]int `json:"paidRewards"`
+	FreeRewards         []map[string]int `json:"freeRewards"`
+}
+
+type BattlePassReward struct {
+	TemplateId string `json:"templateId"`
+	Quantity   int    `json:"quantity"`
+	Tier       int    `json:"tier"`
+	Paid       bool   `json:"paid"`
+}
+
+type BattlePassGrantResult struct {
+	LootItems         []LootResultItem
+	AthenaChanges     []ProfileChange
+	CommonCoreChanges []ProfileChange
+	VBucks            int
+}
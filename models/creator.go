@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CreatorCode struct {
+	gorm.Model
+	Code        string `gorm:"uniqueIndex;default:null" json:"code"`
+	DisplayName string `gorm:"default:null" json:"displayName"`
+	AccountId   string `gorm:"default:null" json:"accountId"`
+	Enabled     bool   `json:"enabled"`
+	CreatedBy   string `gorm:"default:null" json:"createdBy"`
+}
+
+type CreatorPurchase struct {
+	gorm.Model
+	Code       string `gorm:"index;default:null" json:"code"`
+	AccountId  string `gorm:"index;default:null" json:"accountId"`
+	PurchaseId string `gorm:"index;default:null" json:"purchaseId"`
+	OfferId    string `gorm:"default:null" json:"offerId"`
+	Amount     int    `json:"amount"`
+	Refunded   bool   `json:"refunded"`
+}
+
+type CreatorReport struct {
+	Code       string    `json:"code"`
+	From       time.Time `json:"from"`
+	To         time.Time `json:"to"`
+	Purchases  int64     `json:"purchases"`
+	Supporters int64     `json:"supporters"`
+	VBucks     int       `json:"vbucks"`
+	Refunded   int       `json:"refunded"`
+}
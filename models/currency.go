@@ -0,0 +1,21 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type VBucksTransaction struct {
+	gorm.Model
+	AccountId string `gorm:"index;default:null" json:"accountId"`
+	Amount    int    `json:"amount"`
+	Balance   int    `json:"balance"`
+	Reason    string `gorm:"default:null" json:"reason"`
+	Reference string `gorm:"default:null" json:"reference"`
+}
+
+type MtxPurchaseRefund struct {
+	Purchase          MtxPurchase
+	AthenaChanges     []ProfileChange
+	CommonCoreChanges []ProfileChange
+	VBucks            int
+}
@@ -0,0 +1,17 @@
+package models
+
+type GiftHistory struct {
+	NumSent      int                `json:"num_sent"`
+	SentTo       map[string]string  `json:"sentTo"`
+	NumReceived  int                `json:"num_received"`
+	ReceivedFrom map[string]string  `json:"receivedFrom"`
+	Gifts        []GiftHistoryEntry `json:"gifts"`
+}
+
+type GiftHistoryEntry struct {
+	Date          string `json:"date"`
+	OfferId       string `json:"offerId"`
+	ToAccountId   string `json:"toAccountId,omitempty"`
+	FromAccountId string `json:"fromAccountId,omitempty"`
+	GiftBoxId     string `json:"giftBoxId,omitempty"`
+}
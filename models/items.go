@@ -0,0 +1,28 @@
+package models
+
+type ItemInfo struct {
+	TemplateId string `json:"templateId"`
+	Id         string `json:"id"`
+	Type       string `json:"type"`
+	Rarity     string `json:"rarity"`
+	Series     string `json:"series,omitempty"`
+	Season     int    `json:"season"`
+	Set        string `json:"set,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Gender     string `json:"gender,omitempty"`
+}
+
+type ItemQuery struct {
+	Type   string
+	Rarity string
+	Set    string
+	Season int
+	Search string
+	Offset int
+	Limit  int
+}
+
+type ItemSearchResult struct {
+	Total int        `json:"total"`
+	Items []ItemInfo `json:"items"`
+}
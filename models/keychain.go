@@ -0,0 +1,12 @@
+package models
+
+type Keychain struct {
+	Keys []KeychainKey `json:"keys"`
+}
+
+type KeychainKey struct {
+	Guid     string   `json:"guid"`
+	Key      string   `json:"key"`
+	Cosmetic string   `json:"cosmetic,omitempty"`
+	Builds   []string `json:"builds,omitempty"`
+}
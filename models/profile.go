@@ -9,6 +9,8 @@ type UserProfile struct {
   AccountId string `gorm:"default:null" json:"accountId"`
   ProfileId string `gorm:"default:null" json:"profileId"`
   Profile string `gorm:"type:text" json:"profile"`
+  SchemaVersion int `gorm:"default:0" json:"schemaVersion"`
+  RolledSeason int `gorm:"default:0" json:"rolledSeason"`
 }
 
 type UserLoadout struct {
@@ -123,13 +125,11 @@ type CommonCoreStats struct {
 }
 
 type CommonCoreStatsAttributes struct {
-	MtxPurchaseHistory struct {
-		RefundsUsed int `json:"refundsUsed"`
-		RefundCredits int `json:"refundCredits"`
-		Purchases []any `json:"purchases"`
-	} `json:"mtx_purchase_history"`
+	MtxPurchaseHistory MtxPurchaseHistory `json:"mtx_purchase_history"`
 	CurrentMtxPlatform string `json:"current_mtx_platform"`
 	MtxAffiliate string `json:"mtx_affiliate"`
+	MtxAffiliateId string `json:"mtx_affiliate_id,omitempty"`
+	MtxAffiliateSetTime string `json:"mtx_affiliate_set_time,omitempty"`
 }
 
 type Stats struct {
@@ -141,7 +141,7 @@ type StatsAttributes struct {
 	Loadouts                         []string          `json:"loadouts"`
 	RestedXpOverflow                 int               `json:"rested_xp_overflow"`
 	MfaRewardClaimed                 bool              `json:"mfa_reward_claimed"`
-	QuestManager                     map[string]string `json:"quest_manager"`
+	QuestManager                     map[string]any    `json:"quest_manager"`
 	BookLevel                        int               `json:"book_level"`
 	SeasonNum                        int               `json:"season_num"`
 	SeasonUpdate                     int               `json:"season_update"`
@@ -175,6 +175,9 @@ type StatsAttributes struct {
 	FavoriteLoadingScreen            string            `json:"favorite_loadingscreen"`
 	BannerIcon                       string            `json:"banner_icon"`
 	BannerColor                      string            `json:"banner_color"`
+	LoadoutPresets                   map[string]map[string]string `json:"loadout_presets"`
+	LoadoutArchetypeValues           map[string]string `json:"loadout_archetype_values"`
+	UseRandomLoadout                 bool              `json:"use_random_loadout"`
 }
 
 type ProfileResponse struct {
@@ -193,7 +196,7 @@ type ProfileChange struct {
 	ChangeType  string 	`json:"changeType"`
 	ItemID      string 	`json:"itemId"`
 	Quantity    int    	`json:"quantity"`
-	Item   Item   	`json:"item"`
+	Item   any   	`json:"item"`
 	Profile     Profile `json:"profile"`
 	Name        string 	`json:"name"`
 	Value       any    	`json:"value"`
@@ -205,12 +208,36 @@ type Notification struct {
 	Type      string `json:"type"`
 	Primary   bool   `json:"primary"`
 	LootResult LootResult `json:"lootResult"`
+	NewQuestId string `json:"newQuestId,omitempty"`
 }
 
 type LootResult struct {
 	Items []LootResultItem `json:"items"`
 }
 
+type MtxPurchaseHistory struct {
+	RefundsUsed int `json:"refundsUsed"`
+	RefundCredits int `json:"refundCredits"`
+	TokenRefreshReferenceTime string `json:"tokenRefreshReferenceTime,omitempty"`
+	Purchases []MtxPurchase `json:"purchases"`
+}
+
+type MtxPurchase struct {
+	PurchaseId string `json:"purchaseId"`
+	OfferId string `json:"offerId"`
+	PurchaseDate string `json:"purchaseDate"`
+	UndoTimeout string `json:"undoTimeout"`
+	FreeRefundEligible bool `json:"freeRefundEligible"`
+	Refundable bool `json:"refundable"`
+	Quantity int `json:"quantity"`
+	Fulfillments []string `json:"fulfillments"`
+	LootResult []LootResultItem `json:"lootResult"`
+	TotalMtxPaid int `json:"totalMtxPaid"`
+	Metadata map[string]any `json:"metadata"`
+	GameContext string `json:"gameContext"`
+	RefundDate string `json:"refundDate,omitempty"`
+}
+
 type LootResultItem struct {
 	ItemType string `json:"itemType"`
 	ItemGuid string `json:"itemGuid"`
@@ -224,4 +251,19 @@ type MultiUpdate struct {
 	ProfileChangesBaseRevision int           `json:"profileChangesBaseRevision"`
 	ProfileChanges             []ProfileChange `json:"profileChanges"`
 	ProfileCommandRevision     int           `json:"profileCommandRevision"`
-}
\ No newline at end of file
+}
+
+type VariantCatalogueEntry struct {
+	TemplateId string                    `json:"templateId"`
+	Channels   []VariantCatalogueChannel `json:"channels"`
+}
+
+type VariantCatalogueChannel struct {
+	Channel string                `json:"channel"`
+	Tags    []VariantCatalogueTag `json:"tags"`
+}
+
+type VariantCatalogueTag struct {
+	Tag          string `json:"tag"`
+	DefaultOwned bool   `json:"defaultOwned"`
+}
@@ -0,0 +1,33 @@
+package models
+
+type QuestObjective struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type QuestDefinition struct {
+	TemplateId string           `json:"templateId"`
+	Objectives []QuestObjective `json:"objectives"`
+	Rewards    map[string]int   `json:"rewards"`
+	Xp         int              `json:"xp"`
+}
+
+type QuestBundle struct {
+	TemplateId string            `json:"templateId"`
+	UnlockWeek int               `json:"unlockWeek"`
+	Quests     []QuestDefinition `json:"quests"`
+}
+
+type QuestSchedule struct {
+	TemplateId         string        `json:"templateId"`
+	RequiresBattlePass bool          `json:"requiresBattlePass"`
+	Bundles            []QuestBundle `json:"bundles"`
+}
+
+type SeasonQuests struct {
+	SeasonStart     string            `json:"seasonStart"`
+	MaxDailyQuests  int               `json:"maxDailyQuests"`
+	DailyRerolls    int               `json:"dailyRerolls"`
+	Daily           []QuestDefinition `json:"daily"`
+	Schedules       []QuestSchedule   `json:"schedules"`
+}
@@ -0,0 +1,119 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ShopRotationConfig struct {
+	RefreshIntervalHrs int                    `json:"refreshIntervalHrs"`
+	DailyPurchaseHrs   int                    `json:"dailyPurchaseHrs"`
+	RolloverTime       string                 `json:"rolloverTime"`
+	ExcludeTypes       []string               `json:"excludeTypes"`
+	ExcludeIds         []string               `json:"excludeIds"`
+	SeasonEligibility  ShopSeasonEligibility  `json:"seasonEligibility"`
+	Storefronts        []ShopStorefrontConfig `json:"storefronts"`
+	BattlePass         ShopBattlePassConfig   `json:"battlePass"`
+}
+
+type ShopSeasonEligibility struct {
+	MinIntroductionSeason int `json:"minIntroductionSeason"`
+	MaxSeasonsAhead       int `json:"maxSeasonsAhead"`
+}
+
+type ShopStorefrontConfig struct {
+	Name     string              `json:"name"`
+	Sections []ShopSectionConfig `json:"sections"`
+}
+
+type ShopSectionConfig struct {
+	SectionId      string         `json:"sectionId"`
+	Slots          int            `json:"slots"`
+	TileSize       string         `json:"tileSize"`
+	RarityWeights  map[string]int `json:"rarityWeights"`
+	TypeWeights    map[string]int `json:"typeWeights"`
+	CooldownDays   int            `json:"cooldownDays"`
+	GroupSets      bool           `json:"groupSets"`
+	AttachBackpack bool           `json:"attachBackpack"`
+	Refundable     bool           `json:"refundable"`
+	Bundles        int            `json:"bundles"`
+	BundleMinItems int            `json:"bundleMinItems"`
+	BundleDiscount int            `json:"bundleDiscountPercent"`
+	BundleFloor    int            `json:"bundleFloorPrice"`
+}
+
+type ShopBattlePassConfig struct {
+	Enabled                bool                                 `json:"enabled"`
+	StorefrontName         string                               `json:"storefrontName"`
+	BundlePrice            int                                  `json:"bundlePrice"`
+	BundleRegularPrice     int                                  `json:"bundleRegularPrice"`
+	PassPrice              int                                  `json:"passPrice"`
+	TierPrice              int                                  `json:"tierPrice"`
+	BundleDisplayAssetPath string                               `json:"bundleDisplayAssetPath"`
+	PassDisplayAssetPath   string                               `json:"passDisplayAssetPath"`
+	Descriptions           map[string]ShopBattlePassDescription `json:"descriptions"`
+}
+
+type ShopBattlePassDescription struct {
+	Bundle string `json:"bundle"`
+	Pass   string `json:"pass"`
+}
+
+type ShopItemAppearance struct {
+	gorm.Model
+	Date       string `gorm:"index;default:null" json:"date"`
+	TemplateId string `gorm:"index;default:null" json:"templateId"`
+	Storefront string `gorm:"default:null" json:"storefront"`
+	SectionId  string `gorm:"default:null" json:"sectionId"`
+}
+
+type ShopHistory struct {
+	gorm.Model
+	Date   string `gorm:"uniqueIndex;default:null" json:"date"`
+	Reroll int    `gorm:"default:0" json:"reroll"`
+	Seed   int64  `gorm:"default:0" json:"seed"`
+	Shop   string `gorm:"type:text" json:"shop"`
+	AnnouncedEtag string `gorm:"default:null" json:"-"`
+}
+
+type ShopPlan struct {
+	gorm.Model
+	Date      string `gorm:"uniqueIndex;default:null" json:"date"`
+	Plan      string `gorm:"type:text" json:"plan"`
+	CreatedBy string `gorm:"default:null" json:"createdBy"`
+}
+
+type ShopPlanRequest struct {
+	Date        string               `json:"date"`
+	Storefronts []ShopPlanStorefront `json:"storefronts"`
+	BattlePass  bool                 `json:"battlePass"`
+}
+
+type ShopPlanStorefront struct {
+	Name    string          `json:"name"`
+	Entries []ShopPlanEntry `json:"entries"`
+}
+
+type ShopPlanEntry struct {
+	TemplateIds    []string `json:"templateIds"`
+	Set            string   `json:"set"`
+	BundleDiscount int      `json:"bundleDiscountPercent"`
+	SectionId      string   `json:"sectionId"`
+	TileSize       string   `json:"tileSize"`
+	Title          string   `json:"title"`
+	Price          int      `json:"price"`
+	SalePrice      int      `json:"salePrice"`
+	SaleExpiration string   `json:"saleExpiration"`
+	Refundable     bool     `json:"refundable"`
+}
+
+type ShopSale struct {
+	gorm.Model
+	TemplateId      string    `gorm:"default:null" json:"templateId"`
+	Storefront      string    `gorm:"default:null" json:"storefront"`
+	DiscountPercent int       `gorm:"default:0" json:"discountPercent"`
+	StartsAt        time.Time `json:"startsAt"`
+	EndsAt          time.Time `json:"endsAt"`
+	CreatedBy       string    `gorm:"default:null" json:"createdBy"`
+}
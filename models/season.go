@@ -0,0 +1,25 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type SeasonHistory struct {
+	gorm.Model
+	AccountId     string `gorm:"default:null" json:"accountId"`
+	Season        int    `json:"season"`
+	WipeNumber    int    `json:"wipeNumber"`
+	Level         int    `json:"level"`
+	Xp            int    `json:"xp"`
+	BookLevel     int    `json:"bookLevel"`
+	BookXp        int    `json:"bookXp"`
+	BookPurchased bool   `json:"bookPurchased"`
+	Stats         string `gorm:"type:text" json:"stats"`
+}
+
+type SeasonEndReward struct {
+	TemplateId         string `json:"templateId"`
+	Quantity           int    `json:"quantity"`
+	MinBookLevel       int    `json:"minBookLevel"`
+	RequiresBattlePass bool   `json:"requiresBattlePass"`
+}
@@ -41,6 +41,31 @@ type CatalogEntry struct {
 	Title						string            `json:"title"`
 	ShortDescription	string            `json:"shortDescription"`
 	Description				string            `json:"description"`
+	DynamicBundleInfo	*DynamicBundleInfo `json:"dynamicBundleInfo,omitempty"`
+	PlannedSale				*PlannedSale       `json:"plannedSale,omitempty"`
+}
+
+type PlannedSale struct {
+	FinalPrice int    `json:"finalPrice"`
+	Expiration string `json:"expiration"`
+}
+
+type DynamicBundleInfo struct {
+	DiscountedBasePrice int                 `json:"discountedBasePrice"`
+	RegularBasePrice    int                 `json:"regularBasePrice"`
+	FloorPrice          int                 `json:"floorPrice"`
+	CurrencyType        string              `json:"currencyType"`
+	CurrencySubType     string              `json:"currencySubType"`
+	DisplayType         string              `json:"displayType"`
+	BundleItems         []DynamicBundleItem `json:"bundleItems"`
+}
+
+type DynamicBundleItem struct {
+	CanOwnMultiple             bool      `json:"bCanOwnMultiple"`
+	RegularPrice               int       `json:"regularPrice"`
+	DiscountedPrice            int       `json:"discountedPrice"`
+	AlreadyOwnedPriceReduction int       `json:"alreadyOwnedPriceReduction"`
+	Item                       ItemGrant `json:"item"`
 }
 
 type Price struct {
@@ -71,16 +96,29 @@ type BeforeStoreItem struct {
 	Gender							string `json:"gender"`
 	Set									string `json:"set"`
 	Name								string `json:"name"`
+	Series							string `json:"series"`
 }
 
 type BeforeStoreSetMap map[string][]string
 
 type Prices map[string]map[string]int
 
+type PriceTable struct {
+	Types   Prices                    `json:"types"`
+	Series  map[string]map[string]int `json:"series"`
+	Items   map[string]int            `json:"items"`
+}
+
 type SiteShopItem struct {
 	Name 				 string `json:"name"`
 	Price				 int    `json:"price"`
 	ItemId			 string `json:"itemId"`
 	Rarity			 string `json:"rarity"`
 	Season			 int    `json:"season"`
+}
+
+type ClientBuild struct {
+	Season     int `json:"season"`
+	Minor      int `json:"minor"`
+	Changelist int `json:"changelist"`
 }
\ No newline at end of file
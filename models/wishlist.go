@@ -0,0 +1,12 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+type WishlistItem struct {
+	gorm.Model
+	AccountId   string `gorm:"uniqueIndex:idx_wishlist_item;default:null" json:"accountId"`
+	TemplateId  string `gorm:"uniqueIndex:idx_wishlist_item;index;default:null" json:"templateId"`
+	NotifiedFor string `gorm:"default:null" json:"notifiedFor"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type XpCurve struct {
+	LevelXp  []int `json:"levelXp"`
+	MaxLevel int   `json:"maxLevel"`
+	TierXp   int   `json:"tierXp"`
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
@@ -353,6 +354,16 @@ func XMPPSendBodyToAccountId(body map[string]interface{}, accountId string) {
 	`))
 }
 
+// the client only queries its profiles again when it is told about a gift,
+// so anything that changes a profile outside of an mcp request sends this
+func XMPPSendGiftReceived(accountId string) {
+	XMPPSendBodyToAccountId(map[string]interface{}{
+		"payload": map[string]interface{}{},
+		"type": "com.epicgames.gift.received",
+		"timestamp": time.Now().Format("2006-01-02T15:04:05.999Z"),
+	}, accountId)
+}
+
 func XMPPUpdateStatus(accountId string, friendId string) {
 	mainClient, err := XGetClientFromAccountId(accountId)
 	if err != nil {